@@ -0,0 +1,77 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// builderFeeApprovals caches the max builder fee the trading user approved
+// for each builder, keyed by lowercased builder address.
+type builderFeeApprovals struct {
+	mu   sync.Mutex
+	fees map[string]int
+}
+
+func newBuilderFeeApprovals() *builderFeeApprovals {
+	return &builderFeeApprovals{fees: make(map[string]int)}
+}
+
+func (a *builderFeeApprovals) get(builder string) (int, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	fee, ok := a.fees[strings.ToLower(builder)]
+	return fee, ok
+}
+
+func (a *builderFeeApprovals) set(builder string, fee int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.fees[strings.ToLower(builder)] = fee
+}
+
+func (a *builderFeeApprovals) forget(builder string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.fees, strings.ToLower(builder))
+}
+
+// tradingUser returns the address of the account orders are placed for.
+func (e *Exchange) tradingUser() string {
+	switch {
+	case e.vault != "":
+		return e.vault
+	case e.accountAddr != "":
+		return e.accountAddr
+	default:
+		return strings.ToLower(NewPrivateKeySigner(e.privateKey).Address().Hex())
+	}
+}
+
+// checkBuilderFee checks that the trading user approved at least the fee of
+// builder. The approval is fetched once per builder and fetched again only
+// when a higher fee is requested, in case it was raised meanwhile.
+func (e *Exchange) checkBuilderFee(builder BuilderInfo) error {
+	if approved, ok := e.builderFees.get(builder.Builder); ok && builder.Fee <= approved {
+		return nil
+	}
+
+	approved, err := e.info.MaxBuilderFee(e.tradingUser(), strings.ToLower(builder.Builder))
+	if err != nil {
+		return err
+	}
+	e.builderFees.set(builder.Builder, approved)
+
+	if builder.Fee > approved {
+		return ValidationError{
+			Field: "builderFee",
+			Message: fmt.Sprintf(
+				"%d tenths of a basis point exceeds the %d approved for builder %s",
+				builder.Fee, approved, common.HexToAddress(builder.Builder).Hex(),
+			),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBuilder = "0x1924B8561EEF20E70EDE628A296175D358BE80E5"
+
+func TestInfo_MaxBuilderFee(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		assert.Equal(t, "maxBuilderFee", req["type"])
+		assert.Equal(t, testBuilder, req["builder"])
+		return 10
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	fee, err := info.MaxBuilderFee("0xabc", testBuilder)
+	require.NoError(t, err)
+	assert.Equal(t, 10, fee)
+}
+
+func TestExchange_ApproveBuilderFee(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	_, err := exchange.ApproveBuilderFee(testBuilder, 10)
+	require.NoError(t, err)
+	_, err = exchange.ApproveBuilderFee(testBuilder, 1001)
+	assert.Error(t, err)
+
+	require.Len(t, requests, 1)
+	action := requests[0]["action"].(map[string]any)
+	assert.Equal(t, "approveBuilderFee", action["type"])
+	assert.Equal(t, "0.01%", action["maxFeeRate"])
+	assert.Equal(t, "0x1924b8561eef20e70ede628a296175d358be80e5", action["builder"])
+	assert.NotContains(t, requests[0], "vaultAddress")
+}
+
+func TestExchange_OrderBuilder(t *testing.T) {
+	var requests []map[string]any
+	feeRequests := 0
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		if req["type"] == "maxBuilderFee" {
+			feeRequests++
+			assert.Equal(t, testVaultAddress, req["user"])
+			assert.Equal(t, "0x1924b8561eef20e70ede628a296175d358be80e5", req["builder"])
+			return 60
+		}
+		requests = append(requests, req)
+		return json.RawMessage(`{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":77738308}}]}}}`)
+	})
+	order := OrderRequest{
+		Coin:      "BTC",
+		Side:      SideBid,
+		Size:      0.01,
+		LimitPx:   60000,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: "Gtc"}},
+	}
+
+	_, err := exchange.Order(order, nil, false)
+	require.NoError(t, err)
+	assert.NotContains(t, requests[0]["action"], "builder")
+
+	require.NoError(t, exchange.SetDefaultBuilder(&BuilderInfo{Builder: testBuilder, Fee: 50}))
+	_, err = exchange.Order(order, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"b": "0x1924b8561eef20e70ede628a296175d358be80e5",
+		"f": float64(50),
+	}, requests[1]["action"].(map[string]any)["builder"])
+
+	_, err = exchange.Order(order, &BuilderInfo{Builder: testBuilder, Fee: 20}, false)
+	require.NoError(t, err)
+	assert.Equal(t, float64(20), requests[2]["action"].(map[string]any)["builder"].(map[string]any)["f"])
+
+	// Perp builder fees are capped at 0.1%
+	_, err = exchange.Order(order, &BuilderInfo{Builder: testBuilder, Fee: 200}, false)
+	var validationErr ValidationError
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "builderFee", validationErr.Field)
+
+	// The approval is fetched once, then again for a fee above it
+	assert.Equal(t, 1, feeRequests)
+	_, err = exchange.Order(order, &BuilderInfo{Builder: testBuilder, Fee: 80}, false)
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "builderFee", validationErr.Field)
+	assert.Equal(t, 2, feeRequests)
+
+	// The default builder must fit the perp cap
+	assert.Error(t, exchange.SetDefaultBuilder(&BuilderInfo{Builder: testBuilder, Fee: 500}))
+	assert.Error(t, exchange.SetDefaultBuilder(&BuilderInfo{Builder: "builder", Fee: 1}))
+	require.NoError(t, exchange.SetDefaultBuilder(nil))
+	_, err = exchange.Order(order, nil, false)
+	require.NoError(t, err)
+	assert.NotContains(t, requests[3]["action"], "builder")
+	assert.Len(t, requests, 4)
+}
+
+func TestBuilderFeeRate(t *testing.T) {
+	assert.Equal(t, "0.001%", builderFeeRate(1))
+	assert.Equal(t, "0.01%", builderFeeRate(10))
+	assert.Equal(t, "0.1%", builderFeeRate(100))
+	assert.Equal(t, "1%", builderFeeRate(1000))
+}
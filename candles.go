@@ -0,0 +1,160 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// candleSnapshotLimit is the maximum number of candles returned by a candleSnapshot request
+const candleSnapshotLimit = 5000
+
+// Interval is the period of a candle.
+type Interval string
+
+const (
+	Interval1m  Interval = "1m"
+	Interval3m  Interval = "3m"
+	Interval5m  Interval = "5m"
+	Interval15m Interval = "15m"
+	Interval30m Interval = "30m"
+	Interval1h  Interval = "1h"
+	Interval2h  Interval = "2h"
+	Interval4h  Interval = "4h"
+	Interval8h  Interval = "8h"
+	Interval12h Interval = "12h"
+	Interval1d  Interval = "1d"
+	Interval3d  Interval = "3d"
+	Interval1w  Interval = "1w"
+	Interval1M  Interval = "1M"
+)
+
+var intervalDurations = map[Interval]time.Duration{
+	Interval1m:  time.Minute,
+	Interval3m:  3 * time.Minute,
+	Interval5m:  5 * time.Minute,
+	Interval15m: 15 * time.Minute,
+	Interval30m: 30 * time.Minute,
+	Interval1h:  time.Hour,
+	Interval2h:  2 * time.Hour,
+	Interval4h:  4 * time.Hour,
+	Interval8h:  8 * time.Hour,
+	Interval12h: 12 * time.Hour,
+	Interval1d:  24 * time.Hour,
+	Interval3d:  3 * 24 * time.Hour,
+	Interval1w:  7 * 24 * time.Hour,
+	// Months vary in length, 30 days is close enough to split ranges
+	Interval1M: 30 * 24 * time.Hour,
+}
+
+// ParseInterval returns the Interval of s, such as "15m" or "1d".
+func ParseInterval(s string) (Interval, error) {
+	interval := Interval(s)
+	if err := interval.Validate(); err != nil {
+		return "", err
+	}
+	return interval, nil
+}
+
+// Valid reports whether the interval is supported by Hyperliquid.
+func (i Interval) Valid() bool {
+	_, ok := intervalDurations[i]
+	return ok
+}
+
+// Validate returns a ValidationError if the interval isn't supported by
+// Hyperliquid.
+func (i Interval) Validate() error {
+	if !i.Valid() {
+		return ValidationError{Field: "interval", Message: fmt.Sprintf("unsupported candle interval %q", string(i))}
+	}
+	return nil
+}
+
+// Duration returns the period of the interval, or 0 if it isn't valid. 1M is
+// approximated as 30 days.
+func (i Interval) Duration() time.Duration {
+	return intervalDurations[i]
+}
+
+func (i Interval) String() string {
+	return string(i)
+}
+
+// CandlesSnapshot returns the candles of name between startTime and endTime,
+// in milliseconds. At most candleSnapshotLimit candles are returned, use
+// CandlesRange for longer ranges.
+func (i *Info) CandlesSnapshot(name string, interval Interval, startTime, endTime int64) ([]Candle, error) {
+	return i.fetchCandles(context.Background(), name, interval, startTime, endTime)
+}
+
+// CandlesRange returns the candles of name between startTime and endTime, in
+// milliseconds, splitting the range into as many requests as needed. Candles
+// are ordered by open time.
+func (i *Info) CandlesRange(
+	ctx context.Context,
+	name string,
+	interval Interval,
+	startTime, endTime int64,
+) ([]Candle, error) {
+	if err := interval.Validate(); err != nil {
+		return nil, err
+	}
+
+	span := candleSnapshotLimit * interval.Duration().Milliseconds()
+	byTime := make(map[int64]Candle)
+	for start := startTime; start <= endTime; start += span {
+		end := min(start+span-1, endTime)
+		candles, err := i.fetchCandles(ctx, name, interval, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for _, candle := range candles {
+			byTime[candle.Time] = candle
+		}
+	}
+
+	result := make([]Candle, 0, len(byTime))
+	for _, candle := range byTime {
+		result = append(result, candle)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Time < result[b].Time
+	})
+
+	return result, nil
+}
+
+func (i *Info) fetchCandles(
+	ctx context.Context,
+	name string,
+	interval Interval,
+	startTime, endTime int64,
+) ([]Candle, error) {
+	if err := interval.Validate(); err != nil {
+		return nil, err
+	}
+
+	req := map[string]any{
+		"coin":      i.nameToCoin(name),
+		"interval":  interval,
+		"startTime": startTime,
+		"endTime":   endTime,
+	}
+
+	resp, err := i.client.postWithContext(ctx, "/info", map[string]any{
+		"type": "candleSnapshot",
+		"req":  req,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch candles snapshot: %w", err)
+	}
+
+	var result []Candle
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal candles snapshot: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input    string
+		want     Interval
+		duration time.Duration
+		wantErr  bool
+	}{
+		{input: "1m", want: Interval1m, duration: time.Minute},
+		{input: "15m", want: Interval15m, duration: 15 * time.Minute},
+		{input: "8h", want: Interval8h, duration: 8 * time.Hour},
+		{input: "1w", want: Interval1w, duration: 7 * 24 * time.Hour},
+		{input: "1M", want: Interval1M, duration: 30 * 24 * time.Hour},
+		{input: "2m", wantErr: true},
+		{input: "1H", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			interval, err := ParseInterval(tt.input)
+			if tt.wantErr {
+				var validationErr ValidationError
+				assert.True(t, errors.As(err, &validationErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, interval)
+			assert.Equal(t, tt.duration, interval.Duration())
+		})
+	}
+}
+
+func TestCandle_UnmarshalJSON(t *testing.T) {
+	data := `{"t":1719792000000,"T":1719792059999,"s":"BTC","i":"1m","o":"62000.5","c":"62010.0","h":"62020.0","l":"61990.5","v":"12.345","n":87}`
+
+	var candle Candle
+	require.NoError(t, json.Unmarshal([]byte(data), &candle))
+	assert.Equal(t, Candle{
+		Timestamp: 1719792059999,
+		Close:     62010,
+		High:      62020,
+		Interval:  Interval1m,
+		Low:       61990.5,
+		Number:    87,
+		Open:      62000.5,
+		Symbol:    "BTC",
+		Time:      1719792000000,
+		Volume:    12.345,
+	}, candle)
+}
+
+func TestInfo_CandlesRange(t *testing.T) {
+	minute := time.Minute.Milliseconds()
+
+	var requests []map[string]any
+	server := newTestServer(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		params := req["req"].(map[string]any)
+		start, end := int64(params["startTime"].(float64)), int64(params["endTime"].(float64))
+
+		// Overlap the previous request by one candle to exercise deduplication
+		var candles []Candle
+		for open := max(start-minute, 0); open <= end; open += minute {
+			candles = append(candles, Candle{Symbol: "BTC", Interval: Interval1m, Time: open, Timestamp: open + minute - 1})
+		}
+		return candles
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	endTime := (2*candleSnapshotLimit+10)*minute - 1
+	candles, err := info.CandlesRange(context.Background(), "BTC", Interval1m, 0, endTime)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, "1m", requests[0]["req"].(map[string]any)["interval"])
+	assert.Equal(t, float64(candleSnapshotLimit*minute), requests[1]["req"].(map[string]any)["startTime"])
+
+	require.Len(t, candles, 2*candleSnapshotLimit+10)
+	for i, candle := range candles {
+		require.Equal(t, int64(i)*minute, candle.Time)
+	}
+
+	_, err = info.CandlesRange(context.Background(), "BTC", Interval("2m"), 0, endTime)
+	var validationErr ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Len(t, requests, 3)
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync/atomic"
+	"time"
 )
 
 const (
@@ -24,20 +26,57 @@ const (
 type Client struct {
 	baseURL    string
 	httpClient *http.Client
+	// limiter is nil when requests aren't rate limited
+	limiter atomic.Pointer[rateLimiter]
 }
 
-func NewClient(baseURL string) *Client {
+// ClientOption configures the Client of NewClient, NewInfo and NewExchange.
+type ClientOption func(*Client)
+
+// WithRateLimit limits the request weight sent per window, such as
+// IPRateLimitWeight per IPRateLimitWindow. Requests are not limited by default.
+func WithRateLimit(weight int, window time.Duration) ClientOption {
+	return func(c *Client) {
+		c.SetRateLimit(weight, window)
+	}
+}
+
+func NewClient(baseURL string, opts ...ClientOption) *Client {
 	if baseURL == "" {
 		baseURL = MainnetAPIURL
 	}
 
-	return &Client{
+	client := &Client{
 		baseURL:    baseURL,
 		httpClient: new(http.Client),
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
+}
+
+// SetRateLimit limits the request weight sent per window, replacing any
+// previous limit. A non-positive weight disables limiting.
+func (c *Client) SetRateLimit(weight int, window time.Duration) {
+	if weight <= 0 {
+		c.limiter.Store(nil)
+		return
+	}
+	c.limiter.Store(newRateLimiter(weight, window))
 }
 
 func (c *Client) post(path string, payload any) ([]byte, error) {
+	return c.postWithContext(context.Background(), path, payload)
+}
+
+func (c *Client) postWithContext(ctx context.Context, path string, payload any) ([]byte, error) {
+	if limiter := c.limiter.Load(); limiter != nil {
+		if err := limiter.wait(ctx, requestWeight(path, payload)); err != nil {
+			return nil, fmt.Errorf("rate limit: %w", err)
+		}
+	}
+
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal payload: %w", err)
@@ -45,7 +84,7 @@ func (c *Client) post(path string, payload any) ([]byte, error) {
 
 	url := c.baseURL + path
 	req, err := http.NewRequestWithContext(
-		context.Background(),
+		ctx,
 		"POST",
 		url,
 		bytes.NewBuffer(jsonData),
@@ -71,11 +110,7 @@ func (c *Client) post(path string, payload any) ([]byte, error) {
 	}
 
 	if resp.StatusCode >= httpErrorStatusCode {
-		var apiErr APIError
-		if err := json.Unmarshal(body, &apiErr); err != nil {
-			return nil, fmt.Errorf("status %d: %s", resp.StatusCode, string(body))
-		}
-		return nil, apiErr
+		return nil, newAPIError(resp.StatusCode, body)
 	}
 
 	return body, nil
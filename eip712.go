@@ -0,0 +1,145 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	stdmath "math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// userSignedDomainName is the EIP-712 domain name of user-signed actions
+	userSignedDomainName = "HyperliquidSignTransaction"
+	// userSignedDomainVersion is the EIP-712 domain version of user-signed actions
+	userSignedDomainVersion = "1"
+	// userSignedChainID is the chain id user-signed actions are signed for, on mainnet and testnet alike
+	userSignedChainID = 0x66eee
+
+	mainnetChainName = "Mainnet"
+	testnetChainName = "Testnet"
+)
+
+// EIP712Field is a member of an EIP-712 struct type, such as
+// {Name: "destination", Type: "string"}.
+type EIP712Field = apitypes.Type
+
+var eip712DomainFields = []EIP712Field{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+	{Name: "verifyingContract", Type: "address"},
+}
+
+// SignUserSignedAction signs an action with EIP-712, as required for actions
+// moving funds out of the control of the signer. The action is updated with
+// the signatureChainId and hyperliquidChain fields it must be sent with.
+func SignUserSignedAction(
+	privateKey *ecdsa.PrivateKey,
+	action map[string]any,
+	fields []EIP712Field,
+	primaryType string,
+	isMainnet bool,
+) (Signature, error) {
+	setUserSignedChain(action, isMainnet)
+
+	hash, err := userSignedActionHash(action, fields, primaryType)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	return signHash(NewPrivateKeySigner(privateKey), hash)
+}
+
+// setUserSignedChain sets the chain fields user-signed actions are sent with.
+func setUserSignedChain(action map[string]any, isMainnet bool) {
+	action["signatureChainId"] = hexutil.EncodeUint64(userSignedChainID)
+	action["hyperliquidChain"] = testnetChainName
+	if isMainnet {
+		action["hyperliquidChain"] = mainnetChainName
+	}
+}
+
+// userSignedActionHash returns the EIP-712 digest of a user-signed action.
+func userSignedActionHash(action map[string]any, fields []EIP712Field, primaryType string) ([]byte, error) {
+	message, err := userSignedMessage(action, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash %s: %w", primaryType, err)
+	}
+
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainFields,
+			primaryType:    fields,
+		},
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              userSignedDomainName,
+			Version:           userSignedDomainVersion,
+			ChainId:           math.NewHexOrDecimal256(userSignedChainID),
+			VerifyingContract: common.Address{}.Hex(),
+		},
+		Message: message,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash %s: %w", primaryType, err)
+	}
+	return hash, nil
+}
+
+// userSignedMessage returns the EIP-712 message of an action, made of its
+// fields listed in fields with integers as the *big.Int apitypes expects.
+func userSignedMessage(action map[string]any, fields []EIP712Field) (apitypes.TypedDataMessage, error) {
+	message := make(apitypes.TypedDataMessage, len(fields))
+	for _, field := range fields {
+		value, ok := action[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %s", field.Name)
+		}
+		if strings.HasPrefix(field.Type, "uint") || strings.HasPrefix(field.Type, "int") {
+			n, err := eip712Integer(value)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", field.Name, err)
+			}
+			value = n
+		}
+		message[field.Name] = value
+	}
+	return message, nil
+}
+
+// eip712Integer converts an integer field to a *big.Int. Actions decoded by
+// encoding/json carry their integers as float64 or, with UseNumber, as
+// json.Number, which keeps integers above 2^53 exact.
+func eip712Integer(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case *big.Int:
+		return v, nil
+	case float64:
+		if stdmath.IsNaN(v) || stdmath.IsInf(v, 0) || v != stdmath.Trunc(v) {
+			return nil, fmt.Errorf("expected integer, got %v", v)
+		}
+		n, _ := big.NewFloat(v).Int(nil)
+		return n, nil
+	case json.Number:
+		n, ok := new(big.Int).SetString(v.String(), 10)
+		if !ok {
+			return nil, fmt.Errorf("expected integer, got %s", v)
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("expected integer, got %T", value)
+	}
+}
@@ -0,0 +1,133 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testValidator = "0x5ac99df645f3414876c816caa18b2d234024b487"
+
+func TestUserSignedActionHash(t *testing.T) {
+	action := map[string]any{
+		"type":             "tokenDelegate",
+		"signatureChainId": "0x66eee",
+		"hyperliquidChain": "Mainnet",
+		"validator":        testValidator,
+		"wei":              uint64(100000000),
+		"isUndelegate":     false,
+		"nonce":            int64(1719792000000),
+	}
+
+	_, err := userSignedActionHash(action, tokenDelegateFields, "HyperliquidTransaction:TokenDelegate")
+	require.NoError(t, err)
+
+	delete(action, "validator")
+	_, err = userSignedActionHash(action, tokenDelegateFields, "HyperliquidTransaction:TokenDelegate")
+	assert.ErrorContains(t, err, "missing field validator")
+}
+
+func TestUserSignedActionHash_SDKVectors(t *testing.T) {
+	// Signatures by testSDKKey from test_sign_usd_transfer_action and
+	// test_sign_withdraw_from_bridge_action of the Python SDK's signing_test.py
+	signer := common.HexToAddress("0x14791697260E4c9A71f18484C9f997B308e59325")
+
+	tests := []struct {
+		name        string
+		actionType  string
+		fields      []EIP712Field
+		primaryType string
+		signature   string
+	}{
+		{
+			name:        "usd_send",
+			actionType:  "usdSend",
+			fields:      usdSendFields,
+			primaryType: "HyperliquidTransaction:UsdSend",
+			signature:   `{"r":"0x637b37dd731507cdd24f46532ca8ba6eec616952c56218baeff04144e4a77073","s":"0x11a6a24900e6e314136d2592e2f8d502cd89b7c15b198e1bee043c9589f9fad7","v":27}`,
+		},
+		{
+			name:        "withdraw",
+			actionType:  "withdraw3",
+			fields:      withdrawFields,
+			primaryType: "HyperliquidTransaction:Withdraw",
+			signature:   `{"r":"0x8363524c799e90ce9bc41022f7c39b4e9bdba786e5f9c72b20e43e1462c37cf9","s":"0x58b1411a775938b83e29182e8ef74975f9054c8e97ebf5ec2dc8d51bfc893881","v":28}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := map[string]any{
+				"type":             tt.actionType,
+				"signatureChainId": "0x66eee",
+				"hyperliquidChain": "Testnet",
+				"destination":      "0x5e9ee1089755c3435139848e47e6635505d5a13a",
+				"amount":           "1",
+				"time":             int64(1687816341423),
+			}
+			hash, err := userSignedActionHash(action, tt.fields, tt.primaryType)
+			require.NoError(t, err)
+
+			var sig Signature
+			require.NoError(t, json.Unmarshal([]byte(tt.signature), &sig))
+			recovered, err := recoverSigner(hash, sig)
+			require.NoError(t, err)
+			assert.Equal(t, signer, recovered)
+		})
+	}
+}
+
+func TestSignUserSignedAction(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	action := map[string]any{"type": "cDeposit", "wei": uint64(5), "nonce": int64(1)}
+	sig, err := SignUserSignedAction(privateKey, action, stakingTransferFields, "HyperliquidTransaction:CDeposit", false)
+	require.NoError(t, err)
+	assert.Equal(t, "0x66eee", action["signatureChainId"])
+	assert.Equal(t, "Testnet", action["hyperliquidChain"])
+
+	hash, err := userSignedActionHash(action, stakingTransferFields, "HyperliquidTransaction:CDeposit")
+	require.NoError(t, err)
+
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), recoverTestSigner(t, hash, sig))
+}
+
+func TestUserSignedActionHash_Values(t *testing.T) {
+	tests := []struct {
+		typ     string
+		value   any
+		wantErr bool
+	}{
+		{typ: "string", value: "Mainnet"},
+		{typ: "address", value: testValidator},
+		{typ: "bool", value: true},
+		{typ: "uint64", value: uint64(1)},
+		{typ: "int64", value: int64(-1)},
+		{typ: "uint64", value: float64(1700000000000)},
+		{typ: "uint64", value: json.Number("18000000000000000000")},
+		{typ: "address", value: "0x1234", wantErr: true},
+		{typ: "uint64", value: int64(-1), wantErr: true},
+		{typ: "uint64", value: "1", wantErr: true},
+		{typ: "bool", value: "true", wantErr: true},
+		{typ: "uint64", value: 1.5, wantErr: true},
+		{typ: "uint64", value: json.Number("1.5"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ, func(t *testing.T) {
+			fields := []EIP712Field{{Name: "value", Type: tt.typ}}
+			hash, err := userSignedActionHash(map[string]any{"value": tt.value}, fields, "Test")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, hash, 32)
+		})
+	}
+}
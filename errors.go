@@ -1,15 +1,113 @@
 package hyperliquid
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
 
+// Errors matching the rejections of Hyperliquid, for use with errors.Is on the
+// APIError and OrderError returned by the API.
+var (
+	ErrInsufficientMargin = errors.New("insufficient margin")
+	ErrTickSize           = errors.New("price not divisible by tick size")
+	ErrMinNotional        = errors.New("order below minimum notional")
+	ErrReduceOnly         = errors.New("reduce only order would increase position")
+	ErrPostOnlyWouldCross = errors.New("post only order would cross")
+	ErrUnknownAsset       = errors.New("unknown asset")
+	ErrRateLimited        = errors.New("rate limited")
+	ErrInvalidNonce       = errors.New("invalid nonce")
+	ErrSignatureMismatch  = errors.New("signature mismatch")
+	// ErrActionExpired is returned for actions that reached Hyperliquid after
+	// their expiresAfter timestamp.
+	ErrActionExpired = errors.New("action expired")
+)
+
+// errorMessages maps lowercased fragments of Hyperliquid error messages to
+// the errors they match, the first matching fragment winning.
+var errorMessages = []struct {
+	fragment string
+	err      error
+}{
+	{"insufficient margin", ErrInsufficientMargin},
+	{"tick size", ErrTickSize},
+	{"minimum value", ErrMinNotional},
+	{"reduce only order would increase position", ErrReduceOnly},
+	{"post only order would have immediately matched", ErrPostOnlyWouldCross},
+	{"invalid asset", ErrUnknownAsset},
+	{"unknown asset", ErrUnknownAsset},
+	{"too many", ErrRateLimited},
+	{"rate limit", ErrRateLimited},
+	{"action expired at", ErrActionExpired},
+	{"nonce", ErrInvalidNonce},
+	// Signatures over a different payload recover to an unknown address
+	{"user or api wallet", ErrSignatureMismatch},
+	{"signature", ErrSignatureMismatch},
+}
+
+// matchError returns the error matching a Hyperliquid error message, nil if
+// there is none.
+func matchError(msg string) error {
+	msg = strings.ToLower(msg)
+	for _, m := range errorMessages {
+		if strings.Contains(msg, m.fragment) {
+			return m.err
+		}
+	}
+	return nil
+}
+
+// APIError is an error returned by Hyperliquid, either as an HTTP error
+// status or as a rejected action. It unwraps to the matching Err* error.
 type APIError struct {
-	Code    int    `json:"code"`
-	Message string `json:"msg"`
-	Data    any    `json:"data,omitempty"`
+	// StatusCode is the HTTP status, 0 for rejected actions
+	StatusCode int
+	Message    string
 }
 
 func (e APIError) Error() string {
-	return fmt.Sprintf("API error %d: %s", e.Code, e.Message)
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("API error %d: %s", e.StatusCode, e.Message)
+	}
+	return "API error: " + e.Message
+}
+
+func (e APIError) Unwrap() error {
+	if e.StatusCode == http.StatusTooManyRequests {
+		return ErrRateLimited
+	}
+	return matchError(e.Message)
+}
+
+// newAPIError returns the APIError of an HTTP error status with the given
+// body, the body of rejected actions being a JSON string.
+func newAPIError(statusCode int, body []byte) APIError {
+	var msg string
+	if err := json.Unmarshal(body, &msg); err != nil {
+		msg = strings.TrimSpace(string(body))
+	}
+	if msg == "" {
+		msg = http.StatusText(statusCode)
+	}
+	return APIError{StatusCode: statusCode, Message: msg}
+}
+
+// OrderError is the rejection of a single order or cancel of a request, the
+// request itself being accepted. It unwraps to the matching Err* error.
+type OrderError struct {
+	// Index is the position of the order in the request
+	Index   int
+	Message string
+}
+
+func (e OrderError) Error() string {
+	return fmt.Sprintf("order %d rejected: %s", e.Index, e.Message)
+}
+
+func (e OrderError) Unwrap() error {
+	return matchError(e.Message)
 }
 
 type ValidationError struct {
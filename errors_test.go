@@ -0,0 +1,223 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchError(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want error
+	}{
+		{"Insufficient margin to place order. asset=0", ErrInsufficientMargin},
+		{"Price must be divisible by tick size. asset=0", ErrTickSize},
+		{"Order must have minimum value of $10. asset=3", ErrMinNotional},
+		{"Reduce only order would increase position. asset=0", ErrReduceOnly},
+		{"Post only order would have immediately matched, bbo was 1.0@2.0. asset=0", ErrPostOnlyWouldCross},
+		{"Invalid asset", ErrUnknownAsset},
+		{"Too many cumulative requests sent (10250 > 10000) for cumulative volume traded $0.", ErrRateLimited},
+		{"Invalid nonce: duplicate nonce 1700000000000", ErrInvalidNonce},
+		{"User or API Wallet 0x0000000000000000000000000000000000000001 does not exist.", ErrSignatureMismatch},
+		{"Action expired at 1700000005000", ErrActionExpired},
+		{"Vault is expired", nil},
+		{"Order has zero size.", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.msg, func(t *testing.T) {
+			assert.Equal(t, tt.want, matchError(tt.msg))
+		})
+	}
+}
+
+func TestClient_HTTPError(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+		msg    string
+	}{
+		{"rate_limited", http.StatusTooManyRequests, "null", ErrRateLimited, "Too Many Requests"},
+		{"plain_text", http.StatusUnprocessableEntity, "Failed to deserialize the JSON body into the target type\n", nil, "Failed to deserialize the JSON body into the target type"},
+		{"json_string", http.StatusInternalServerError, `"Invalid nonce"`, ErrInvalidNonce, "Invalid nonce"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(server.Close)
+
+			_, err := NewClient(server.URL).post("/info", map[string]any{"type": "meta"})
+			var apiErr APIError
+			require.True(t, errors.As(err, &apiErr))
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, tt.msg, apiErr.Message)
+			if tt.want != nil {
+				assert.True(t, errors.Is(err, tt.want))
+			} else {
+				assert.Nil(t, errors.Unwrap(err))
+			}
+		})
+	}
+}
+
+func TestExchange_RejectedAction(t *testing.T) {
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		return map[string]any{"status": "err", "response": "User or API Wallet 0x0000000000000000000000000000000000000001 does not exist."}
+	})
+
+	_, err := exchange.UpdateLeverage("BTC", 5, false)
+	assert.True(t, errors.Is(err, ErrSignatureMismatch))
+
+	var apiErr APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Zero(t, apiErr.StatusCode)
+}
+
+func TestExchange_BulkOrdersStatuses(t *testing.T) {
+	fixture, err := os.ReadFile(filepath.Join("testdata", "order_statuses.json"))
+	require.NoError(t, err)
+
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		return json.RawMessage(fixture)
+	})
+	order := OrderRequest{
+		Coin:      "BTC",
+		Side:      SideBid,
+		Size:      0.01,
+		LimitPx:   60000,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: "Gtc"}},
+	}
+
+	results, err := exchange.BulkOrders([]OrderRequest{order, order, order, order, order}, nil, false)
+	require.Error(t, err)
+	require.Len(t, results, 5)
+
+	assert.Equal(t, int64(77738308), results[0].Oid())
+	assert.Equal(t, &FilledOrder{TotalSz: 0.02, AvgPx: 1891.4, Oid: 77747314}, results[1].Filled)
+	assert.Equal(t, "waitingForTrigger", results[3].Status)
+	assert.Zero(t, results[3].Oid())
+
+	assert.True(t, errors.Is(err, ErrMinNotional))
+	assert.True(t, errors.Is(err, ErrPostOnlyWouldCross))
+	assert.False(t, errors.Is(err, ErrInsufficientMargin))
+
+	var orderErr OrderError
+	require.True(t, errors.As(err, &orderErr))
+	assert.Equal(t, 2, orderErr.Index)
+
+	_, err = exchange.BulkOrders([]OrderRequest{{Coin: "DOGE", Side: SideAsk}}, nil, false)
+	assert.True(t, errors.Is(err, ErrUnknownAsset))
+
+	var validationErr ValidationError
+	_, err = exchange.BulkOrders([]OrderRequest{{Coin: "BTC"}}, nil, false)
+	require.True(t, errors.As(err, &validationErr))
+	assert.Equal(t, "side", validationErr.Field)
+}
+
+func TestExchange_CancelAllStatuses(t *testing.T) {
+	var actions []any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		if req["type"] == "openOrders" {
+			assert.Equal(t, testVaultAddress, req["user"])
+			return json.RawMessage(`[
+				{"coin":"BTC","limitPx":"60000","oid":11,"side":"B","sz":"0.01","timestamp":1700000000000},
+				{"coin":"ETH","limitPx":"2000","oid":12,"side":"A","sz":"1","timestamp":1700000000000},
+				{"coin":"BTC","limitPx":"61000","oid":13,"side":"A","sz":"0.01","timestamp":1700000000000}
+			]`)
+		}
+		actions = append(actions, req["action"])
+		return json.RawMessage(`{"status":"ok","response":{"type":"cancel","data":{"statuses":[
+			"success",
+			{"error":"Order was never placed, already canceled, or filled. asset=0"}
+		]}}}`)
+	})
+
+	results, err := exchange.CancelAll("BTC", false)
+	require.Error(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "success", results[0].Status)
+
+	var orderErr OrderError
+	require.True(t, errors.As(err, &orderErr))
+	assert.Equal(t, 1, orderErr.Index)
+
+	require.Len(t, actions, 1)
+	assert.Equal(t, map[string]any{
+		"type": "cancel",
+		"cancels": []any{
+			map[string]any{"a": float64(0), "o": float64(11)},
+			map[string]any{"a": float64(0), "o": float64(13)},
+		},
+	}, actions[0])
+
+	results, err = exchange.CancelAll("PURR/USDC", true)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+	assert.Len(t, actions, 1)
+}
+
+func TestExchange_CancelAllSpot(t *testing.T) {
+	var actions []any
+	server := newTestServer(t, func(req map[string]any) any {
+		if req["type"] == "openOrders" {
+			return json.RawMessage(`[
+				{"coin":"PURR/USDC","limitPx":"0.2","oid":21,"side":"B","sz":"100","timestamp":1700000000000},
+				{"coin":"PURR","limitPx":"0.2","oid":22,"side":"B","sz":"100","timestamp":1700000000000},
+				{"coin":"@1","limitPx":"15","oid":23,"side":"A","sz":"2","timestamp":1700000000000}
+			]`)
+		}
+		actions = append(actions, req["action"])
+		return json.RawMessage(`{"status":"ok","response":{"type":"cancel","data":{"statuses":["success"]}}}`)
+	})
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	spotMeta := testSpotMeta()
+	spotMeta.Universe = append(spotMeta.Universe, SpotAssetInfo{Name: "@1", Tokens: []int{2, 0}, Index: 1})
+	spotMeta.Tokens = append(spotMeta.Tokens, SpotTokenInfo{Name: "HFUN", SzDecimals: 2, WeiDecimals: 8, Index: 2})
+	exchange := NewExchange(privateKey, server.URL, testMeta(), "", "", spotMeta)
+
+	_, err = exchange.CancelAll("PURR", true)
+	require.NoError(t, err)
+	_, err = exchange.CancelAll("@1", true)
+	require.NoError(t, err)
+
+	require.Len(t, actions, 2)
+	assert.Equal(t, map[string]any{
+		"type":    "cancel",
+		"cancels": []any{map[string]any{"a": float64(10000), "o": float64(21)}},
+	}, actions[0])
+	assert.Equal(t, map[string]any{
+		"type":    "cancel",
+		"cancels": []any{map[string]any{"a": float64(10001), "o": float64(23)}},
+	}, actions[1])
+}
+
+func TestOrderResult_JSON(t *testing.T) {
+	for _, raw := range []string{
+		`"success"`,
+		`{"resting":{"oid":1,"cloid":"0x00000000000000000000000000000001"}}`,
+		`{"filled":{"totalSz":"0.02","avgPx":"1891.4","oid":2}}`,
+		`{"error":"Order was never placed, already canceled, or filled."}`,
+	} {
+		var result OrderResult
+		require.NoError(t, json.Unmarshal([]byte(raw), &result))
+		data, err := json.Marshal(result)
+		require.NoError(t, err)
+		assert.JSONEq(t, raw, string(data))
+	}
+}
@@ -14,7 +14,7 @@ func TestCancelOrder(t *testing.T) {
 	// First place an order to cancel
 	orderReq := hyperliquid.OrderRequest{
 		Coin:    "BTC",
-		IsBuy:   true,
+		Side:    hyperliquid.SideBid,
 		Size:    0.1,
 		LimitPx: 40000.0,
 		OrderType: hyperliquid.OrderType{
@@ -24,16 +24,16 @@ func TestCancelOrder(t *testing.T) {
 		},
 	}
 
-	resp, err := exchange.Order(orderReq, nil)
+	resp, err := exchange.Order(orderReq, nil, false)
 	if err != nil {
 		t.Fatalf("Failed to place order: %v", err)
 	}
 
 	// Extract order ID from response
-	orderID := resp.Oid
+	orderID := resp.Oid()
 
 	// Cancel the order
-	cancelResp, err := exchange.Cancel("BTC", orderID)
+	cancelResp, err := exchange.Cancel("BTC", orderID, false)
 	if err != nil {
 		t.Fatalf("Failed to cancel order: %v", err)
 	}
@@ -54,7 +54,7 @@ func TestCancelByCloid(t *testing.T) {
 	// Place an order with cloid
 	orderReq := hyperliquid.OrderRequest{
 		Coin:    "BTC",
-		IsBuy:   true,
+		Side:    hyperliquid.SideBid,
 		Size:    0.1,
 		LimitPx: 40000.0,
 		OrderType: hyperliquid.OrderType{
@@ -65,13 +65,13 @@ func TestCancelByCloid(t *testing.T) {
 		Cloid: &cloid,
 	}
 
-	_, err := exchange.Order(orderReq, nil)
+	_, err := exchange.Order(orderReq, nil, false)
 	if err != nil {
 		t.Fatalf("Failed to place order: %v", err)
 	}
 
 	// Cancel by cloid
-	cancelResp, err := exchange.CancelByCloid("BTC", cloid)
+	cancelResp, err := exchange.CancelByCloid("BTC", cloid, false)
 	if err != nil {
 		t.Fatalf("Failed to cancel order by cloid: %v", err)
 	}
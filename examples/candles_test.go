@@ -18,12 +18,12 @@ func TestCandlesSnapshot(t *testing.T) {
 	tests := []struct {
 		name     string
 		coin     string
-		interval string
+		interval hyperliquid.Interval
 	}{
-		{name: "BTC 1m", coin: "BTC", interval: "1m"},
-		{name: "ETH 5m", coin: "ETH", interval: "5m"},
-		{name: "BTC 15m", coin: "BTC", interval: "15m"},
-		{name: "ETH 1h", coin: "ETH", interval: "1h"},
+		{name: "BTC 1m", coin: "BTC", interval: hyperliquid.Interval1m},
+		{name: "ETH 5m", coin: "ETH", interval: hyperliquid.Interval5m},
+		{name: "BTC 15m", coin: "BTC", interval: hyperliquid.Interval15m},
+		{name: "ETH 1h", coin: "ETH", interval: hyperliquid.Interval1h},
 	}
 
 	for _, tt := range tests {
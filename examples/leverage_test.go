@@ -9,8 +9,9 @@ func TestUpdateLeverage(t *testing.T) {
 
 	leverage := 5 // 5x leverage
 	coin := "BTC"
+	isCross := true
 
-	resp, err := exchange.UpdateLeverage(coin, leverage)
+	resp, err := exchange.UpdateLeverage(coin, leverage, isCross)
 	if err != nil {
 		t.Fatalf("Failed to update leverage: %v", err)
 	}
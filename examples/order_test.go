@@ -18,7 +18,7 @@ func TestOrder(t *testing.T) {
 			name: "limit buy order",
 			req: hyperliquid.OrderRequest{
 				Coin:    "BTC",
-				IsBuy:   true,
+				Side:    hyperliquid.SideBid,
 				Size:    0.001, // Smaller size for testing
 				LimitPx: 40000.0,
 				OrderType: hyperliquid.OrderType{
@@ -32,7 +32,7 @@ func TestOrder(t *testing.T) {
 			name: "market sell order",
 			req: hyperliquid.OrderRequest{
 				Coin:    "ETH",
-				IsBuy:   false,
+				Side:    hyperliquid.SideAsk,
 				Size:    0.01,
 				LimitPx: 2000.0,
 				OrderType: hyperliquid.OrderType{
@@ -46,7 +46,7 @@ func TestOrder(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			resp, err := exchange.Order(tt.req, nil)
+			resp, err := exchange.Order(tt.req, nil, false)
 			if err != nil {
 				t.Fatalf("Order failed: %v", err)
 			}
@@ -4,33 +4,114 @@ import (
 	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// vaultlessActions are the action types never performed on behalf of a vault.
+var vaultlessActions = map[string]struct{}{
+	"usdClassTransfer":       {},
+	"vaultTransfer":          {},
+	"createSubAccount":       {},
+	"subAccountTransfer":     {},
+	"subAccountSpotTransfer": {},
+	"tokenDelegate":          {},
+	"cDeposit":               {},
+	"cWithdraw":              {},
+	"convertToMultiSigUser":  {},
+	"approveBuilderFee":      {},
+	"setReferrer":            {},
+	"registerReferrer":       {},
+	"claimRewards":           {},
+	"spotSend":               {},
+	"withdraw3":              {},
+}
+
+var tokenDelegateFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "validator", Type: "address"},
+	{Name: "wei", Type: "uint64"},
+	{Name: "isUndelegate", Type: "bool"},
+	{Name: "nonce", Type: "uint64"},
+}
+
+var approveBuilderFeeFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "maxFeeRate", Type: "string"},
+	{Name: "builder", Type: "address"},
+	{Name: "nonce", Type: "uint64"},
+}
+
+const (
+	// maxPerpBuilderFee is the highest builder fee of perp orders, in tenths of a basis point (0.1%)
+	maxPerpBuilderFee = 100
+	// maxSpotBuilderFee is the highest builder fee of spot orders, in tenths of a basis point (1%)
+	maxSpotBuilderFee = 1000
 )
 
+var usdClassTransferFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "amount", Type: "string"},
+	{Name: "toPerp", Type: "bool"},
+	{Name: "nonce", Type: "uint64"},
+}
+
+var spotSendFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "destination", Type: "string"},
+	{Name: "token", Type: "string"},
+	{Name: "amount", Type: "string"},
+	{Name: "time", Type: "uint64"},
+}
+
+var withdrawFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "destination", Type: "string"},
+	{Name: "amount", Type: "string"},
+	{Name: "time", Type: "uint64"},
+}
+
+// WithdrawalFee is the fixed fee of bridge withdrawals, in USDC.
+const WithdrawalFee = 1.0
+
+// WithdrawResult is an accepted bridge withdrawal. Received is the amount
+// destination gets once the fee is deducted, and Time the nonce of the
+// withdrawal.
+type WithdrawResult struct {
+	Destination string
+	Amount      float64
+	Fee         float64
+	Received    float64
+	Time        int64
+}
+
+// stakingTransferFields are the fields of the cDeposit and cWithdraw actions.
+var stakingTransferFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "wei", Type: "uint64"},
+	{Name: "nonce", Type: "uint64"},
+}
+
 type Exchange struct {
 	client      *Client
 	privateKey  *ecdsa.PrivateKey
 	vault       string
 	accountAddr string
 	info        *Info
+	builder     *BuilderInfo
+	builderFees *builderFeeApprovals
+	nonces      *nonceManager
+	// expiresAfter is the window after which L1 actions expire, 0 for never
+	expiresAfter time.Duration
 }
 
 // executeAction executes an action and unmarshals the response into the given result
-func (e *Exchange) executeAction(action map[string]any, result any) error {
-	timestamp := time.Now().UnixMilli()
-
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.client.baseURL == MainnetAPIURL,
-	)
-	if err != nil {
-		return err
-	}
-
-	resp, err := e.postAction(action, sig, timestamp)
+func (e *Exchange) executeAction(action ActionFields, result any) error {
+	resp, err := e.postL1Action(action)
 	if err != nil {
 		return err
 	}
@@ -48,163 +129,264 @@ func NewExchange(
 	meta *Meta,
 	vaultAddr, accountAddr string,
 	spotMeta *SpotMeta,
+	opts ...ClientOption,
 ) *Exchange {
+	// The exchange and info requests share the client, and so its rate limit
+	info := NewInfo(baseURL, true, meta, spotMeta, opts...)
 	return &Exchange{
-		client:      NewClient(baseURL),
+		client:      info.client,
 		privateKey:  privateKey,
 		vault:       vaultAddr,
 		accountAddr: accountAddr,
-		info:        NewInfo(baseURL, true, meta, spotMeta),
+		info:        info,
+		builderFees: newBuilderFeeApprovals(),
+		nonces:      nonceManagerFor(NewPrivateKeySigner(privateKey).Address()),
 	}
 }
 
-func (e *Exchange) Order(req OrderRequest, builder *BuilderInfo, isSpot bool) (*OpenOrder, error) {
-	orders, err := e.BulkOrders([]OrderRequest{req}, builder, isSpot)
+// Order places an order, returning an OrderError when it is rejected.
+func (e *Exchange) Order(req OrderRequest, builder *BuilderInfo, isSpot bool) (*OrderResult, error) {
+	results, err := e.BulkOrders([]OrderRequest{req}, builder, isSpot)
+	return firstOrderResult(results, err)
+}
+
+// SetDefaultBuilder sets the builder attached to orders placed without one,
+// nil to stop attaching a builder. Its fee must be within the perp cap, the
+// lowest of the perp and spot caps.
+func (e *Exchange) SetDefaultBuilder(builder *BuilderInfo) error {
+	if builder != nil {
+		if err := validateBuilder(*builder, maxPerpBuilderFee); err != nil {
+			return err
+		}
+	}
+	e.builder = builder
+	return nil
+}
+
+// BulkOrders places orders, returning the result of each. Rejected orders
+// don't fail the others, their OrderError being joined in the returned error.
+func (e *Exchange) BulkOrders(orders []OrderRequest, builder *BuilderInfo, isSpot bool) ([]OrderResult, error) {
+	if builder == nil {
+		builder = e.builder
+	}
+
+	action, err := e.OrderAction(orders, builder, isSpot)
 	if err != nil {
 		return nil, err
 	}
-	if len(orders) == 0 {
-		return nil, nil
+	if builder != nil {
+		if err := e.checkBuilderFee(*builder); err != nil {
+			return nil, err
+		}
 	}
-	return &orders[0], nil
+
+	return e.executeOrderAction(action)
 }
 
-func (e *Exchange) BulkOrders(orders []OrderRequest, builder *BuilderInfo, isSpot bool) ([]OpenOrder, error) {
-	timestamp := time.Now().UnixMilli()
+// OrderAction builds the action placing orders as BulkOrders sends it, with
+// the fee of builder when not nil. It is the inner action of a MultiSigAction
+// placing orders for a multi-sig user, whose approval of the builder fee isn't
+// checked.
+func (e *Exchange) OrderAction(orders []OrderRequest, builder *BuilderInfo, isSpot bool) (ActionFields, error) {
+	if builder != nil {
+		maxFee := maxPerpBuilderFee
+		if isSpot {
+			maxFee = maxSpotBuilderFee
+		}
+		if err := validateBuilder(*builder, maxFee); err != nil {
+			return nil, err
+		}
+		// Builder addresses must be sent lowercased
+		builder = &BuilderInfo{Builder: strings.ToLower(builder.Builder), Fee: builder.Fee}
+	}
 
 	orderWires := make([]OrderWire, len(orders))
 	for i, order := range orders {
-		var assetID int
-		var ok bool
-
-		if isSpot {
-			assetID, ok = e.info.SpotAsset(order.Coin)
-			if !ok {
-				return nil, fmt.Errorf("spot asset not found: %s", order.Coin)
-			}
-		} else {
-			assetID, ok = e.info.PerpAsset(order.Coin)
-			if !ok {
-				return nil, fmt.Errorf("perp asset not found: %s", order.Coin)
+		if !order.Side.Valid() {
+			return nil, ValidationError{
+				Field:   "side",
+				Message: fmt.Sprintf("must be %q or %q, got %q", SideBid, SideAsk, order.Side),
 			}
 		}
 
-		wire := OrderRequestToWire(order, assetID)
-		orderWires[i] = wire
+		assetID, err := e.orderAsset(order.Coin, isSpot)
+		if err != nil {
+			return nil, err
+		}
 
-		fmt.Printf("orderWire[%d]: %+v\n", i, wire)
+		orderWires[i] = OrderRequestToWire(order, assetID)
 	}
 
-	action := map[string]any{
-		"type":   "order",
-		"orders": orderWires,
-		//"grouping": "na",
+	action := ActionFields{
+		{"type", "order"},
+		{"orders", orderWires},
+		{"grouping", "na"},
 	}
 	if builder != nil {
-		action["builder"] = builder
+		action = append(action, ActionField{"builder", builder})
 	}
 
-	fmt.Printf("action: %+v", action)
-	fmt.Println("isMainnet", e.client.baseURL == MainnetAPIURL)
+	return action, nil
+}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.client.baseURL == MainnetAPIURL,
-	)
+// Cancel cancels an order, returning an OrderError when it is rejected.
+func (e *Exchange) Cancel(coin string, oid int64, isSpot bool) (*OrderResult, error) {
+	asset, err := e.orderAsset(coin, isSpot)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Println("sig:", sig)
+	return firstOrderResult(e.bulkCancel([]CancelWire{{Asset: asset, Oid: oid}}))
+}
 
-	resp, err := e.postAction(action, sig, timestamp)
+// CancelByCloid cancels an order by client order id, returning an OrderError
+// when it is rejected.
+func (e *Exchange) CancelByCloid(coin, cloid string, isSpot bool) (*OrderResult, error) {
+	asset, err := e.orderAsset(coin, isSpot)
 	if err != nil {
 		return nil, err
 	}
 
-	fmt.Printf("resp: %s\n", string(resp))
-
-	var result []OpenOrder
-	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, err
+	action := ActionFields{
+		{"type", "cancelByCloid"},
+		{"cancels", []CancelByCloidWire{{Asset: asset, Cloid: cloid}}},
 	}
 
-	fmt.Printf("result: %+v\n", result)
+	return firstOrderResult(e.executeOrderAction(action))
+}
+
+// bulkCancel cancels orders by oid in a single action.
+func (e *Exchange) bulkCancel(cancels []CancelWire) ([]OrderResult, error) {
+	action := ActionFields{
+		{"type", "cancel"},
+		{"cancels", cancels},
+	}
 
-	return result, nil
+	return e.executeOrderAction(action)
 }
 
-func (e *Exchange) Cancel(coin string, oid int64) (*OpenOrder, error) {
-	action := map[string]any{
-		"type": "cancel",
-		"coin": coin,
-		"oid":  oid,
+// orderAsset returns the asset id of the perp or, when isSpot is set, spot
+// market coin.
+func (e *Exchange) orderAsset(coin string, isSpot bool) (int, error) {
+	if isSpot {
+		asset, ok := e.info.SpotAsset(coin)
+		if !ok {
+			return 0, fmt.Errorf("%w: spot %s", ErrUnknownAsset, coin)
+		}
+		return asset, nil
 	}
 
-	var result OpenOrder
-	if err := e.executeAction(action, &result); err != nil {
-		return nil, err
+	asset, ok := e.info.PerpAsset(coin)
+	if dex, _ := splitPerpDexName(coin); !ok && dex != "" {
+		// Builder-deployed dexes are loaded on first use
+		if err := e.info.LoadPerpDexs(dex); err != nil {
+			return 0, err
+		}
+		asset, ok = e.info.PerpAsset(coin)
 	}
-	return &result, nil
+	if !ok {
+		return 0, fmt.Errorf("%w: perp %s", ErrUnknownAsset, coin)
+	}
+	return asset, nil
 }
 
-func (e *Exchange) CancelByCloid(coin, cloid string) (*OpenOrder, error) {
-	action := map[string]any{
-		"type":  "cancelByCloid",
-		"coin":  coin,
-		"cloid": cloid,
+// openOrderAsset resolves the coin of an open order to its asset id. Open spot
+// orders carry the API name of their pair ("PURR/USDC", "@107"), which tells
+// them apart from perp orders on a coin sharing the base token name.
+func (e *Exchange) openOrderAsset(coin string, isSpot bool) (int, bool) {
+	if !isSpot {
+		return e.info.PerpAsset(coin)
+	}
+	market, ok := e.info.SpotMarket(coin)
+	if !ok || market.Name != coin {
+		return 0, false
 	}
+	return market.AssetID, true
+}
 
-	var result OpenOrder
-	if err := e.executeAction(action, &result); err != nil {
+// executeOrderAction executes an order or cancel action, returning the status
+// of each order along with the joined errors of the rejected ones.
+func (e *Exchange) executeOrderAction(action ActionFields) ([]OrderResult, error) {
+	resp, err := e.postL1Action(action)
+	if err != nil {
 		return nil, err
 	}
-	return &result, nil
+	return decodeOrderStatuses(resp)
 }
 
-func (e *Exchange) CancelAll(coin string) ([]OpenOrder, error) {
-	timestamp := time.Now().UnixMilli()
-
-	action := map[string]any{
-		"type": "cancelAll",
-		"coin": coin,
+// firstOrderResult returns the result of a single order request.
+func firstOrderResult(results []OrderResult, err error) (*OrderResult, error) {
+	if len(results) == 0 {
+		return nil, err
 	}
+	return &results[0], err
+}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.client.baseURL == MainnetAPIURL,
-	)
+// CancelAll cancels the open orders of the trading user on coin in a single
+// action, returning the result of each cancel along with the joined
+// OrderError of the rejected ones. Hyperliquid has no cancel all action, so
+// orders placed after the open orders are fetched are left open.
+func (e *Exchange) CancelAll(coin string, isSpot bool) ([]OrderResult, error) {
+	asset, err := e.orderAsset(coin, isSpot)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(action, sig, timestamp)
+	orders, err := e.info.OpenOrders(e.tradingUser())
 	if err != nil {
 		return nil, err
 	}
 
-	var result []OpenOrder
-	if err := json.Unmarshal(resp, &result); err != nil {
+	var cancels []CancelWire
+	for _, order := range orders {
+		if orderAsset, ok := e.openOrderAsset(order.Coin, isSpot); ok && orderAsset == asset {
+			cancels = append(cancels, CancelWire{Asset: asset, Oid: order.Oid})
+		}
+	}
+	if len(cancels) == 0 {
+		return nil, nil
+	}
+
+	return e.bulkCancel(cancels)
+}
+
+// UpdateLeverage sets the leverage of coin, with cross margin when isCross is
+// set and isolated margin otherwise.
+func (e *Exchange) UpdateLeverage(coin string, leverage int, isCross bool) (*ActionResponse, error) {
+	assetID, err := e.orderAsset(coin, false)
+	if err != nil {
 		return nil, err
 	}
+	assetInfo, _ := e.info.PerpAssetInfo(coin)
+
+	if leverage < 1 {
+		return nil, ValidationError{Field: "leverage", Message: "must be at least 1"}
+	}
+	if assetInfo.MaxLeverage > 0 && leverage > assetInfo.MaxLeverage {
+		return nil, ValidationError{
+			Field:   "leverage",
+			Message: fmt.Sprintf("must be between 1 and %d for %s", assetInfo.MaxLeverage, coin),
+		}
+	}
+	if isCross && assetInfo.OnlyIsolated {
+		return nil, ValidationError{Field: "isCross", Message: fmt.Sprintf("%s only supports isolated margin", coin)}
+	}
+
+	action := ActionFields{
+		{"type", "updateLeverage"},
+		{"asset", assetID},
+		{"isCross", isCross},
+		{"leverage", leverage},
+	}
 
-	return result, nil
+	return e.executeCheckedAction(action)
 }
 
-func (e *Exchange) UpdateLeverage(coin string, leverage int) (*UserState, error) {
-	action := map[string]any{
-		"type": "updateLeverage",
-		"coin": coin,
-		"leverage": map[string]any{
-			"type":  "isolated",
-			"value": leverage,
-		},
+func (e *Exchange) UpdateIsolatedMargin(coin string, margin float64) (*UserState, error) {
+	action := ActionFields{
+		{"type", "updateIsolatedMargin"},
+		{"coin", coin},
+		{"marginDelta", margin},
 	}
 
 	var result UserState
@@ -214,11 +396,43 @@ func (e *Exchange) UpdateLeverage(coin string, leverage int) (*UserState, error)
 	return &result, nil
 }
 
-func (e *Exchange) UpdateIsolatedMargin(coin string, margin float64) (*UserState, error) {
+// Withdraw3 withdraws amount of USDC from the perp balance to destination on
+// Arbitrum through the bridge. WithdrawalFee is deducted from amount.
+func (e *Exchange) Withdraw3(destination string, amount float64) (*WithdrawResult, error) {
+	if err := validateAddress("destination", destination); err != nil {
+		return nil, err
+	}
+	if amount <= WithdrawalFee {
+		return nil, ValidationError{
+			Field:   "amount",
+			Message: fmt.Sprintf("must be greater than the %v USDC withdrawal fee", WithdrawalFee),
+		}
+	}
+
 	action := map[string]any{
-		"type":        "updateIsolatedMargin",
-		"coin":        coin,
-		"marginDelta": margin,
+		"type":        "withdraw3",
+		"destination": destination,
+		"amount":      strconv.FormatFloat(amount, 'f', -1, 64),
+	}
+
+	if _, err := e.executeUserSignedAction(action, "time", withdrawFields, "HyperliquidTransaction:Withdraw"); err != nil {
+		return nil, err
+	}
+
+	return &WithdrawResult{
+		Destination: destination,
+		Amount:      amount,
+		Fee:         WithdrawalFee,
+		Received:    amount - WithdrawalFee,
+		Time:        action["time"].(int64),
+	}, nil
+}
+
+func (e *Exchange) Transfer(amount float64, destination string) (*UserState, error) {
+	action := ActionFields{
+		{"type", "transfer"},
+		{"destination", destination},
+		{"amount", amount},
 	}
 
 	var result UserState
@@ -228,104 +442,415 @@ func (e *Exchange) UpdateIsolatedMargin(coin string, margin float64) (*UserState
 	return &result, nil
 }
 
-func (e *Exchange) WithdrawEth(amount float64, destination string) (*UserState, error) {
-	timestamp := time.Now().UnixMilli()
+// VaultTransfer deposits usd into vault, or withdraws it when isDeposit is false.
+func (e *Exchange) VaultTransfer(vault string, isDeposit bool, usd float64) (*ActionResponse, error) {
+	if usd <= 0 {
+		return nil, ValidationError{Field: "usd", Message: "must be positive"}
+	}
+
+	action := ActionFields{
+		{"type", "vaultTransfer"},
+		{"vaultAddress", vault},
+		{"isDeposit", isDeposit},
+		{"usd", usdToMicros(usd)},
+	}
+
+	return e.executeCheckedAction(action)
+}
+
+// CreateSubAccount creates a sub-account of the signer named name and returns
+// its address.
+func (e *Exchange) CreateSubAccount(name string) (string, error) {
+	if name == "" {
+		return "", ValidationError{Field: "name", Message: "cannot be empty"}
+	}
+
+	action := ActionFields{
+		{"type", "createSubAccount"},
+		{"name", name},
+	}
+
+	resp, err := e.executeCheckedAction(action)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(resp.Response, &result); err != nil {
+		return "", fmt.Errorf("failed to unmarshal sub account: %w", err)
+	}
+	return result.Data, nil
+}
+
+// SubAccountTransfer moves usd of perp balance to a sub-account, or back from
+// it when isDeposit is false.
+func (e *Exchange) SubAccountTransfer(subAccountUser string, isDeposit bool, usd float64) (*ActionResponse, error) {
+	if usd <= 0 {
+		return nil, ValidationError{Field: "usd", Message: "must be positive"}
+	}
+
+	action := ActionFields{
+		{"type", "subAccountTransfer"},
+		{"subAccountUser", subAccountUser},
+		{"isDeposit", isDeposit},
+		{"usd", usdToMicros(usd)},
+	}
+
+	return e.executeCheckedAction(action)
+}
+
+// SubAccountSpotTransfer moves amount of a spot token to a sub-account, or
+// back from it when isDeposit is false. token is either the name of the
+// token, such as "PURR", or its "NAME:tokenId" identifier.
+func (e *Exchange) SubAccountSpotTransfer(
+	subAccountUser string,
+	isDeposit bool,
+	token string,
+	amount float64,
+) (*ActionResponse, error) {
+	if amount <= 0 {
+		return nil, ValidationError{Field: "amount", Message: "must be positive"}
+	}
+
+	tokenID, err := e.info.SpotTokenID(token)
+	if err != nil {
+		return nil, err
+	}
+
+	action := ActionFields{
+		{"type", "subAccountSpotTransfer"},
+		{"subAccountUser", subAccountUser},
+		{"isDeposit", isDeposit},
+		{"token", tokenID},
+		{"amount", strconv.FormatFloat(amount, 'f', -1, 64)},
+	}
+
+	return e.executeCheckedAction(action)
+}
+
+// TokenDelegate delegates wei of staked HYPE to validator, or undelegates it
+// when isUndelegate is set.
+func (e *Exchange) TokenDelegate(validator string, wei uint64, isUndelegate bool) (*ActionResponse, error) {
+	if wei == 0 {
+		return nil, ValidationError{Field: "wei", Message: "must be positive"}
+	}
 
 	action := map[string]any{
-		"type": "withdraw",
-		"coin": "ETH",
-		"amount": map[string]any{
-			"fix":   amount,
-			"asset": "ETH",
-		},
-		"destination": destination,
+		"type":         "tokenDelegate",
+		"validator":    validator,
+		"wei":          wei,
+		"isUndelegate": isUndelegate,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.client.baseURL == MainnetAPIURL,
-	)
+	return e.executeUserSignedAction(action, "nonce", tokenDelegateFields, "HyperliquidTransaction:TokenDelegate")
+}
+
+// CDeposit moves wei of HYPE from the spot balance to the staking balance.
+func (e *Exchange) CDeposit(wei uint64) (*ActionResponse, error) {
+	return e.stakingTransfer("cDeposit", "HyperliquidTransaction:CDeposit", wei)
+}
+
+// CWithdraw moves wei of HYPE from the staking balance back to the spot
+// balance, after the unstaking queue.
+func (e *Exchange) CWithdraw(wei uint64) (*ActionResponse, error) {
+	return e.stakingTransfer("cWithdraw", "HyperliquidTransaction:CWithdraw", wei)
+}
+
+func (e *Exchange) stakingTransfer(actionType, primaryType string, wei uint64) (*ActionResponse, error) {
+	if wei == 0 {
+		return nil, ValidationError{Field: "wei", Message: "must be positive"}
+	}
+
+	action := map[string]any{
+		"type": actionType,
+		"wei":  wei,
+	}
+
+	return e.executeUserSignedAction(action, "nonce", stakingTransferFields, primaryType)
+}
+
+// executeUserSignedAction signs an action with EIP-712 and executes it. The
+// nonce is set in the nonceField of the action, as its name varies between
+// actions.
+func (e *Exchange) executeUserSignedAction(
+	action map[string]any,
+	nonceField string,
+	fields []EIP712Field,
+	primaryType string,
+) (*ActionResponse, error) {
+	nonce := e.nonces.next()
+	action[nonceField] = nonce
+
+	sig, err := SignUserSignedAction(e.privateKey, action, fields, primaryType, e.client.baseURL == MainnetAPIURL)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(action, sig, timestamp)
+	resp, err := e.postAction(action, e.vault, sig, nonce, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var result UserState
+	return decodeActionResponse(resp)
+}
+
+// decodeActionResponse decodes the response of an action, returning the
+// error of rejected ones.
+func decodeActionResponse(resp []byte) (*ActionResponse, error) {
+	var result ActionResponse
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, err
 	}
-
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
-func (e *Exchange) WithdrawUsdc(amount float64, destination string) (*UserState, error) {
-	timestamp := time.Now().UnixMilli()
+// UsdClassTransfer moves amount of USDC from the spot balance to the perp
+// balance, or the other way around when toPerp is false. When the Exchange
+// trades for a vault or sub-account, the transfer is made on its balances.
+func (e *Exchange) UsdClassTransfer(amount float64, toPerp bool) (*ActionResponse, error) {
+	if amount <= 0 {
+		return nil, ValidationError{Field: "amount", Message: "must be positive"}
+	}
+
+	strAmount := strconv.FormatFloat(amount, 'f', -1, 64)
+	if e.vault != "" {
+		strAmount += " subaccount:" + e.vault
+	}
 
 	action := map[string]any{
-		"type": "usdTransfer",
-		"amount": map[string]any{
-			"fix":   amount,
-			"asset": "USDC",
-		},
-		"destination": destination,
+		"type":   "usdClassTransfer",
+		"amount": strAmount,
+		"toPerp": toPerp,
 	}
 
-	sig, err := SignL1Action(
-		e.privateKey,
-		action,
-		e.vault,
-		timestamp,
-		e.client.baseURL == MainnetAPIURL,
-	)
+	return e.executeUserSignedAction(action, "nonce", usdClassTransferFields, "HyperliquidTransaction:UsdClassTransfer")
+}
+
+// SpotSend sends amount of a spot token to destination. token is either the
+// name of the token, such as "PURR", or its "NAME:tokenId" identifier.
+func (e *Exchange) SpotSend(destination, token string, amount float64) (*ActionResponse, error) {
+	action, err := e.SpotSendAction(destination, token, amount)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := e.postAction(action, sig, timestamp)
-	if err != nil {
+	return e.executeUserSignedAction(action.Action.toMap(), action.NonceField, action.Fields, action.PrimaryType)
+}
+
+// SetReferrer sets the referrer of the signer to the owner of code. It can
+// only be set once.
+func (e *Exchange) SetReferrer(code string) (*ActionResponse, error) {
+	if code == "" {
+		return nil, ValidationError{Field: "code", Message: "cannot be empty"}
+	}
+
+	return e.executeCheckedAction(ActionFields{
+		{"type", "setReferrer"},
+		{"code", code},
+	})
+}
+
+// RegisterReferrer registers code as the referral code of the signer.
+func (e *Exchange) RegisterReferrer(code string) (*ActionResponse, error) {
+	if code == "" {
+		return nil, ValidationError{Field: "code", Message: "cannot be empty"}
+	}
+
+	return e.executeCheckedAction(ActionFields{
+		{"type", "registerReferrer"},
+		{"code", code},
+	})
+}
+
+// ClaimRewards claims the unclaimed referral rewards of the signer.
+func (e *Exchange) ClaimRewards() (*ActionResponse, error) {
+	return e.executeCheckedAction(ActionFields{
+		{"type", "claimRewards"},
+	})
+}
+
+// ApproveBuilderFee allows builder to charge fees of up to maxFee, in tenths
+// of a basis point, on the orders of the signer.
+func (e *Exchange) ApproveBuilderFee(builder string, maxFee int) (*ActionResponse, error) {
+	if err := validateBuilder(BuilderInfo{Builder: builder, Fee: maxFee}, maxSpotBuilderFee); err != nil {
 		return nil, err
 	}
 
-	var result UserState
-	if err := json.Unmarshal(resp, &result); err != nil {
+	action := map[string]any{
+		"type":       "approveBuilderFee",
+		"maxFeeRate": builderFeeRate(maxFee),
+		"builder":    strings.ToLower(builder),
+	}
+
+	resp, err := e.executeUserSignedAction(action, "nonce", approveBuilderFeeFields, "HyperliquidTransaction:ApproveBuilderFee")
+	if err != nil {
 		return nil, err
 	}
+	// The next order with this builder fetches the new approval
+	e.builderFees.forget(builder)
+	return resp, nil
+}
 
-	return &result, nil
+// validateAddress checks that address is a hex address, whose EIP-55
+// checksum must be valid when it is mixed-case.
+func validateAddress(field, address string) error {
+	if !common.IsHexAddress(address) {
+		return ValidationError{Field: field, Message: fmt.Sprintf("invalid address %s", address)}
+	}
+
+	hex := strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X")
+	if hex != strings.ToLower(hex) && hex != strings.ToUpper(hex) && common.HexToAddress(address).Hex() != "0x"+hex {
+		return ValidationError{Field: field, Message: fmt.Sprintf("invalid checksum for address %s", address)}
+	}
+	return nil
 }
 
-func (e *Exchange) Transfer(amount float64, destination string) (*UserState, error) {
-	action := map[string]any{
-		"type":        "transfer",
-		"destination": destination,
-		"amount":      amount,
+// validateBuilder checks the builder address and that its fee is within maxFee.
+func validateBuilder(builder BuilderInfo, maxFee int) error {
+	if !common.IsHexAddress(builder.Builder) {
+		return ValidationError{Field: "builder", Message: fmt.Sprintf("invalid address %s", builder.Builder)}
+	}
+	if builder.Fee < 0 || builder.Fee > maxFee {
+		return ValidationError{
+			Field:   "builderFee",
+			Message: fmt.Sprintf("must be between 0 and %d tenths of a basis point, got %d", maxFee, builder.Fee),
+		}
 	}
+	return nil
+}
 
-	var result UserState
+// builderFeeRate formats a fee in tenths of a basis point as the percentage
+// string of approveBuilderFee, such as "0.01%" for 10.
+func builderFeeRate(fee int) string {
+	return strconv.FormatFloat(float64(fee)/1000, 'f', -1, 64) + "%"
+}
+
+// executeCheckedAction executes an action and returns its response, or the
+// error of rejected actions.
+func (e *Exchange) executeCheckedAction(action ActionFields) (*ActionResponse, error) {
+	var result ActionResponse
 	if err := e.executeAction(action, &result); err != nil {
 		return nil, err
 	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
+// usdToMicros converts an USD amount to the integer micro USD of transfer actions.
+func usdToMicros(usd float64) int64 {
+	return int64(math.Round(usd * 1e6))
+}
+
 // ... Additional methods for other operations like cancels, transfers etc.
 
-func (e *Exchange) postAction(action, signature any, nonce int64) ([]byte, error) {
+// actionVault returns the vault address an action is signed and sent for.
+func (e *Exchange) actionVault(action any) string {
+	if _, ok := vaultlessActions[actionType(action)]; ok {
+		return ""
+	}
+	return e.vault
+}
+
+// actionType returns the type of an action built as a map or ActionFields.
+func actionType(action any) string {
+	var typ any
+	switch a := action.(type) {
+	case map[string]any:
+		typ = a["type"]
+	case ActionFields:
+		typ = a.get("type")
+	}
+	s, _ := typ.(string)
+	return s
+}
+
+// SetExpiresAfter makes the L1 actions of the Exchange, such as orders and
+// cancels, expire when they reach Hyperliquid more than window after being
+// signed. A zero window disables expiry.
+func (e *Exchange) SetExpiresAfter(window time.Duration) {
+	e.expiresAfter = window
+}
+
+// WithExpiresAfter returns a copy of the Exchange whose L1 actions expire
+// after window, to override the default expiry of some calls:
+//
+//	exchange.WithExpiresAfter(5 * time.Second).Order(req, nil, false)
+func (e *Exchange) WithExpiresAfter(window time.Duration) *Exchange {
+	c := *e
+	c.expiresAfter = window
+	return &c
+}
+
+// expiry returns the expiresAfter timestamp of an action signed with nonce,
+// nil when actions don't expire.
+func (e *Exchange) expiry(nonce int64) *int64 {
+	if e.expiresAfter <= 0 {
+		return nil
+	}
+	expiresAfter := nonce + e.expiresAfter.Milliseconds()
+	return &expiresAfter
+}
+
+// postL1Action signs an L1 action and sends it, returning the raw response or
+// the error of a rejected action.
+func (e *Exchange) postL1Action(action ActionFields) ([]byte, error) {
+	nonce := e.nonces.next()
+	expiresAfter := e.expiry(nonce)
+	vault := e.actionVault(action)
+
+	sig, err := SignL1ActionWithExpiry(
+		e.privateKey,
+		action,
+		vault,
+		nonce,
+		expiresAfter,
+		e.client.baseURL == MainnetAPIURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.postAction(action, vault, sig, nonce, expiresAfter)
+	if err != nil {
+		return nil, err
+	}
+	if err := actionError(resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// actionError returns the error of a rejected action response, nil for any
+// other response.
+func actionError(resp []byte) error {
+	var envelope ActionResponse
+	if err := json.Unmarshal(resp, &envelope); err != nil || envelope.Status != actionStatusErr {
+		return nil
+	}
+	return envelope.Err()
+}
+
+// postAction sends a signed action, on behalf of vault unless the action type
+// is never performed for a vault.
+func (e *Exchange) postAction(action any, vault string, signature Signature, nonce int64, expiresAfter *int64) ([]byte, error) {
 	payload := map[string]any{
 		"action":    action,
 		"nonce":     nonce,
 		"signature": signature,
 	}
+	if expiresAfter != nil {
+		payload["expiresAfter"] = *expiresAfter
+	}
 
-	if action.(map[string]any)["type"] != "usdClassTransfer" {
-		payload["vaultAddress"] = e.vault
+	if _, vaultless := vaultlessActions[actionType(action)]; !vaultless {
+		payload["vaultAddress"] = vault
 	}
 
 	return e.client.post("/exchange", payload)
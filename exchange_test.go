@@ -0,0 +1,159 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testVaultAddress = "0xdfc24b077bc1425ad1dea75bcb6f8158e10df303"
+
+func newTestExchange(t *testing.T, respond func(req map[string]any) any) *Exchange {
+	t.Helper()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	server := newTestServer(t, respond)
+	return NewExchange(privateKey, server.URL, testMeta(), testVaultAddress, "", testSpotMeta())
+}
+
+func TestExchange_VaultTransfer(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		if req["action"].(map[string]any)["isDeposit"] == false {
+			return map[string]any{"status": "err", "response": "Insufficient vault equity"}
+		}
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	resp, err := exchange.VaultTransfer(testVaultAddress, true, 1520.75)
+	require.NoError(t, err)
+	assert.Equal(t, actionStatusOK, resp.Status)
+
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]any{
+		"type":         "vaultTransfer",
+		"vaultAddress": testVaultAddress,
+		"isDeposit":    true,
+		"usd":          float64(1520750000),
+	}, requests[0]["action"])
+	assert.NotContains(t, requests[0], "vaultAddress")
+
+	_, err = exchange.VaultTransfer(testVaultAddress, false, 100)
+	var apiErr APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Insufficient vault equity", apiErr.Message)
+
+	_, err = exchange.VaultTransfer(testVaultAddress, true, 0)
+	var validationErr ValidationError
+	assert.True(t, errors.As(err, &validationErr))
+	assert.Len(t, requests, 2)
+}
+
+func TestExchange_UpdateLeverage(t *testing.T) {
+	var requests []map[string]any
+	server := newTestServer(t, func(req map[string]any) any {
+		switch req["type"] {
+		case "perpDexs":
+			return []any{nil, map[string]any{"name": "test", "full_name": "test dex"}}
+		case "meta":
+			return Meta{Universe: []AssetInfo{
+				{Name: "test:ABC", SzDecimals: 0, MaxLeverage: 10},
+				{Name: "test:DEF", SzDecimals: 2, MaxLeverage: 5},
+			}}
+		}
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	meta := testMeta()
+	meta.Universe = append(meta.Universe,
+		AssetInfo{Name: "kPEPE", SzDecimals: 0, MaxLeverage: 10, OnlyIsolated: true},
+		AssetInfo{Name: "NEW", SzDecimals: 0},
+	)
+	exchange := NewExchange(privateKey, server.URL, meta, "", "", testSpotMeta())
+
+	resp, err := exchange.UpdateLeverage("ETH", 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, actionStatusOK, resp.Status)
+	require.Len(t, requests, 1)
+	assert.Equal(t, map[string]any{
+		"type":     "updateLeverage",
+		"asset":    float64(2),
+		"isCross":  true,
+		"leverage": float64(10),
+	}, requests[0]["action"])
+
+	_, err = exchange.UpdateLeverage("kPEPE", 5, false)
+	require.NoError(t, err)
+	// Assets without a max leverage have no upper bound
+	_, err = exchange.UpdateLeverage("NEW", 100, false)
+	require.NoError(t, err)
+
+	// Builder-deployed dexes are loaded on first use
+	_, err = exchange.UpdateLeverage("test:DEF", 5, false)
+	require.NoError(t, err)
+	require.Len(t, requests, 4)
+	assert.Equal(t, float64(110001), requests[3]["action"].(map[string]any)["asset"])
+
+	tests := []struct {
+		name     string
+		coin     string
+		leverage int
+		isCross  bool
+		field    string
+		message  string
+	}{
+		{"zero", "BTC", 0, false, "leverage", "must be at least 1"},
+		{"above_max", "BTC", 41, false, "leverage", "must be between 1 and 40 for BTC"},
+		{"no_max", "NEW", 0, false, "leverage", "must be at least 1"},
+		{"only_isolated", "kPEPE", 5, true, "isCross", "kPEPE only supports isolated margin"},
+		{"dex_above_max", "test:DEF", 6, false, "leverage", "must be between 1 and 5 for test:DEF"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := exchange.UpdateLeverage(tt.coin, tt.leverage, tt.isCross)
+			var validationErr ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.field, validationErr.Field)
+			assert.Equal(t, tt.message, validationErr.Message)
+		})
+	}
+
+	_, err = exchange.UpdateLeverage("DOGE", 5, false)
+	assert.True(t, errors.Is(err, ErrUnknownAsset))
+	assert.Len(t, requests, 4)
+}
+
+func TestExchange_Cancel(t *testing.T) {
+	var actions []any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		actions = append(actions, req["action"])
+		return json.RawMessage(`{"status":"ok","response":{"type":"cancel","data":{"statuses":["success"]}}}`)
+	})
+
+	result, err := exchange.Cancel("ETH", 42, false)
+	require.NoError(t, err)
+	assert.Equal(t, "success", result.Status)
+	_, err = exchange.CancelByCloid("PURR/USDC", "0x00000000000000000000000000000001", true)
+	require.NoError(t, err)
+	_, err = exchange.Cancel("DOGE", 42, false)
+	assert.ErrorIs(t, err, ErrUnknownAsset)
+
+	require.Len(t, actions, 2)
+	assert.Equal(t, map[string]any{
+		"type":    "cancel",
+		"cancels": []any{map[string]any{"a": float64(2), "o": float64(42)}},
+	}, actions[0])
+	assert.Equal(t, map[string]any{
+		"type":    "cancelByCloid",
+		"cancels": []any{map[string]any{"asset": float64(10000), "cloid": "0x00000000000000000000000000000001"}},
+	}, actions[1])
+}
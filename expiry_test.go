@@ -0,0 +1,82 @@
+package hyperliquid
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchange_ExpiresAfter(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+	exchange.nonces = &nonceManager{now: func() int64 { return 1700000000000 }}
+	signer := NewPrivateKeySigner(exchange.privateKey).Address()
+
+	action := ActionFields{
+		{"type", "updateLeverage"},
+		{"asset", 0},
+		{"isCross", false},
+		{"leverage", 5},
+	}
+
+	// signedBy asserts that the last request was signed for the given expiry
+	signedBy := func(t *testing.T, expiresAfter *int64) {
+		t.Helper()
+		req := requests[len(requests)-1]
+		nonce := int64(req["nonce"].(float64))
+		hash, err := l1ActionHash(action, testVaultAddress, nonce, expiresAfter, false)
+		require.NoError(t, err)
+		assert.Equal(t, signer, recoverTestSigner(t, hash, req["signature"]))
+	}
+
+	t.Run("default_never_expires", func(t *testing.T) {
+		_, err := exchange.UpdateLeverage("BTC", 5, false)
+		require.NoError(t, err)
+		assert.NotContains(t, requests[len(requests)-1], "expiresAfter")
+		signedBy(t, nil)
+	})
+
+	t.Run("default_window", func(t *testing.T) {
+		exchange.SetExpiresAfter(30 * time.Second)
+		t.Cleanup(func() { exchange.SetExpiresAfter(0) })
+
+		_, err := exchange.UpdateLeverage("BTC", 5, false)
+		require.NoError(t, err)
+		req := requests[len(requests)-1]
+		expiresAfter := int64(req["nonce"].(float64)) + 30000
+		assert.Equal(t, float64(expiresAfter), req["expiresAfter"])
+		signedBy(t, &expiresAfter)
+	})
+
+	t.Run("per_call_override", func(t *testing.T) {
+		_, err := exchange.WithExpiresAfter(5*time.Second).UpdateLeverage("BTC", 5, false)
+		require.NoError(t, err)
+		req := requests[len(requests)-1]
+		expiresAfter := int64(req["nonce"].(float64)) + 5000
+		assert.Equal(t, float64(expiresAfter), req["expiresAfter"])
+		signedBy(t, &expiresAfter)
+
+		assert.Zero(t, exchange.expiresAfter)
+	})
+}
+
+func TestExchange_ActionExpired(t *testing.T) {
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		return map[string]any{"status": "err", "response": "Action expired at 1700000005000"}
+	})
+	exchange.SetExpiresAfter(5 * time.Second)
+
+	_, err := exchange.UpdateLeverage("BTC", 5, false)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrActionExpired))
+
+	var apiErr APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Action expired at 1700000005000", apiErr.Message)
+}
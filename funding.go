@@ -0,0 +1,71 @@
+package hyperliquid
+
+import (
+	"sort"
+	"time"
+)
+
+// FundingTotal is the net funding of a coin over a period. Usdc is negative
+// when funding was paid and positive when it was received.
+type FundingTotal struct {
+	Coin        string
+	PeriodStart time.Time
+	Usdc        float64
+	Payments    int
+}
+
+// AggregateFunding sums funding payments per coin and per period, periods
+// being aligned on the Unix epoch in UTC, e.g. calendar days for 24 hours.
+// A zero period sums the whole history of each coin. Totals are ordered by
+// period, then coin.
+func AggregateFunding(history []UserFundingHistory, period time.Duration) []FundingTotal {
+	type totalKey struct {
+		coin  string
+		start int64
+	}
+
+	totals := make(map[totalKey]*FundingTotal)
+	for _, funding := range history {
+		key := totalKey{coin: funding.Delta.Coin}
+		if period > 0 {
+			// Truncate in nanoseconds, periods under a millisecond having no
+			// whole number of milliseconds
+			at := time.UnixMilli(funding.Time).UnixNano()
+			key.start = at - at%int64(period)
+		}
+
+		total, ok := totals[key]
+		if !ok {
+			total = &FundingTotal{
+				Coin:        key.coin,
+				PeriodStart: time.Unix(0, key.start).UTC(),
+			}
+			totals[key] = total
+		}
+		total.Usdc += funding.Delta.Usdc
+		total.Payments++
+	}
+
+	result := make([]FundingTotal, 0, len(totals))
+	for _, total := range totals {
+		result = append(result, *total)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		if !result[a].PeriodStart.Equal(result[b].PeriodStart) {
+			return result[a].PeriodStart.Before(result[b].PeriodStart)
+		}
+		return result[a].Coin < result[b].Coin
+	})
+
+	return result
+}
+
+// TotalFundingByCoin sums funding payments per coin. Totals are negative for
+// coins on which funding was paid overall.
+func TotalFundingByCoin(history []UserFundingHistory) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, funding := range history {
+		totals[funding.Delta.Coin] += funding.Delta.Usdc
+	}
+	return totals
+}
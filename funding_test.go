@@ -0,0 +1,85 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadUserFunding(t *testing.T) []UserFundingHistory {
+	t.Helper()
+
+	fixture, err := os.ReadFile(filepath.Join("testdata", "user_funding.json"))
+	require.NoError(t, err)
+
+	var history []UserFundingHistory
+	require.NoError(t, json.Unmarshal(fixture, &history))
+	return history
+}
+
+func TestUserFundingHistory_UnmarshalJSON(t *testing.T) {
+	history := loadUserFunding(t)
+	require.Len(t, history, 4)
+
+	assert.Equal(t, int64(1719792000005), history[0].Time)
+	assert.Equal(t, FundingDelta{
+		Type:        "funding",
+		Coin:        "ETH",
+		Usdc:        -1.537084,
+		Szi:         2.5,
+		FundingRate: 0.0000180919,
+	}, history[0].Delta)
+}
+
+func TestAggregateFunding(t *testing.T) {
+	history := loadUserFunding(t)
+
+	t.Run("daily", func(t *testing.T) {
+		totals := AggregateFunding(history, 24*time.Hour)
+		require.Len(t, totals, 3)
+
+		day1 := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, "BTC", totals[0].Coin)
+		assert.Equal(t, day1, totals[0].PeriodStart)
+		assert.InDelta(t, 0.402157, totals[0].Usdc, 1e-9)
+
+		assert.Equal(t, "ETH", totals[1].Coin)
+		assert.Equal(t, day1, totals[1].PeriodStart)
+		assert.InDelta(t, -2.949085, totals[1].Usdc, 1e-9)
+		assert.Equal(t, 2, totals[1].Payments)
+
+		assert.Equal(t, "ETH", totals[2].Coin)
+		assert.Equal(t, day1.AddDate(0, 0, 1), totals[2].PeriodStart)
+		assert.InDelta(t, 0.2, totals[2].Usdc, 1e-9)
+	})
+
+	t.Run("whole_history", func(t *testing.T) {
+		totals := AggregateFunding(history, 0)
+		require.Len(t, totals, 2)
+		assert.Equal(t, 3, totals[1].Payments)
+		assert.InDelta(t, -2.749085, totals[1].Usdc, 1e-9)
+	})
+
+	t.Run("sub_millisecond", func(t *testing.T) {
+		totals := AggregateFunding(history, 500*time.Microsecond)
+		require.Len(t, totals, len(history))
+		for i, total := range totals {
+			assert.Equal(t, 1, total.Payments)
+			assert.Equal(t, 0, total.PeriodStart.Nanosecond()%int(time.Millisecond))
+			if i > 0 {
+				assert.False(t, total.PeriodStart.Before(totals[i-1].PeriodStart))
+			}
+		}
+	})
+
+	t.Run("by_coin", func(t *testing.T) {
+		totals := TotalFundingByCoin(history)
+		assert.InDelta(t, 0.402157, totals["BTC"], 1e-9)
+		assert.InDelta(t, -2.749085, totals["ETH"], 1e-9)
+	})
+}
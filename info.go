@@ -1,26 +1,47 @@
 package hyperliquid
 
 import (
+	"cmp"
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const (
 	// spotAssetIndexOffset is the offset added to spot asset indices
 	spotAssetIndexOffset = 10000
+	// perpDexAssetIndexOffset is the offset added to builder-deployed perp asset indices
+	perpDexAssetIndexOffset = 100000
+	// perpDexAssetIndexStride is the asset id range reserved for each builder-deployed perp dex
+	perpDexAssetIndexStride = 10000
+	// perpDexSeparator separates the dex name from the coin in builder-deployed perp names
+	perpDexSeparator = ":"
 )
 
 type Info struct {
-	client         *Client
-	coinToAsset    map[string]int
-	nameToCoin     map[string]string
-	assetToDecimal map[int]int
-	spotToAsset    map[string]int
-	perpToAsset    map[string]int
+	client *Client
+
+	// refreshMu serializes Refresh and LoadPerpDexs, so that metadata fetched
+	// by one never overwrites the newer metadata of another
+	refreshMu sync.Mutex
+	// mu guards the asset metadata, which Refresh replaces as a whole
+	mu           sync.RWMutex
+	sources      registrySources
+	registry     *assetRegistry
+	listeners    map[int]func([]AssetChange)
+	nextListener int
 }
 
 // postTimeRangeRequest makes a POST request with time range parameters
 func (i *Info) postTimeRangeRequest(
+	ctx context.Context,
 	requestType, user string,
 	startTime int64,
 	endTime *int64,
@@ -40,21 +61,17 @@ func (i *Info) postTimeRangeRequest(
 		payload[k] = v
 	}
 
-	resp, err := i.client.post("/info", payload)
+	resp, err := i.client.postWithContext(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch %s: %w", requestType, err)
 	}
 	return resp, nil
 }
 
-func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta) *Info {
+func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta, opts ...ClientOption) *Info {
 	info := &Info{
-		client:         NewClient(baseURL),
-		coinToAsset:    make(map[string]int),
-		nameToCoin:     make(map[string]string),
-		assetToDecimal: make(map[int]int),
-		spotToAsset:    make(map[string]int),
-		perpToAsset:    make(map[string]int),
+		client:    NewClient(baseURL, opts...),
+		listeners: make(map[int]func([]AssetChange)),
 	}
 
 	if meta == nil {
@@ -73,47 +90,309 @@ func NewInfo(baseURL string, skipWS bool, meta *Meta, spotMeta *SpotMeta) *Info
 		}
 	}
 
-	for _, spotInfo := range spotMeta.Universe {
-		token := spotMeta.Tokens[spotInfo.Tokens[0]]
-		symbol := token.Name
+	info.sources = registrySources{
+		meta:     meta,
+		spotMeta: spotMeta,
+		dexMetas: make(map[string]perpDexMeta),
+	}
+
+	registry, err := buildAssetRegistry(info.sources)
+	if err != nil {
+		panic(err)
+	}
+	info.registry = registry
+
+	return info
+}
+
+// assets returns the current asset registry snapshot.
+func (i *Info) assets() *assetRegistry {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.registry
+}
+
+// LoadPerpDexs fetches the meta of the given builder-deployed perp dexes, or of
+// all of them when none is given, so that their "dex:COIN" perps can be
+// resolved by PerpAsset and NameToAsset. Loaded dexes are kept up to date by
+// Refresh.
+func (i *Info) LoadPerpDexs(dexs ...string) error {
+	i.refreshMu.Lock()
+	defer i.refreshMu.Unlock()
+
+	dexMetas, missing, err := i.fetchPerpDexMetas(context.Background(), dexs)
+	if err != nil {
+		return err
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("perp dex not found: %s", strings.Join(missing, ", "))
+	}
 
-		if _, ok := info.spotToAsset[symbol]; ok {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	sources := i.sources
+	sources.dexMetas = make(map[string]perpDexMeta, len(i.sources.dexMetas)+len(dexMetas))
+	for dex, dexMeta := range i.sources.dexMetas {
+		sources.dexMetas[dex] = dexMeta
+	}
+	for dex, dexMeta := range dexMetas {
+		sources.dexMetas[dex] = dexMeta
+	}
+
+	registry, err := buildAssetRegistry(sources)
+	if err != nil {
+		return err
+	}
+	i.sources, i.registry = sources, registry
+
+	return nil
+}
+
+// fetchPerpDexMetas fetches the meta of the given builder-deployed perp dexes,
+// or of all of them when dexs is empty. The dexes that don't exist are
+// returned as missing.
+func (i *Info) fetchPerpDexMetas(
+	ctx context.Context,
+	dexs []string,
+) (dexMetas map[string]perpDexMeta, missing []string, err error) {
+	perpDexs, err := i.fetchPerpDexs(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indices := make(map[string]int, len(perpDexs))
+	for index, perpDex := range perpDexs {
+		// The first entry is the default dex, which is always loaded
+		if perpDex == nil || index == 0 {
 			continue
 		}
+		indices[perpDex.Name] = index
+	}
 
-		asset := spotInfo.Index + spotAssetIndexOffset
-		info.spotToAsset[symbol] = asset
-		info.coinToAsset[symbol] = asset
-		info.nameToCoin[symbol] = symbol
-		info.assetToDecimal[asset] = token.SzDecimals
+	if len(dexs) == 0 {
+		for name := range indices {
+			dexs = append(dexs, name)
+		}
 	}
 
-	for asset, assetInfo := range meta.Universe {
-		info.perpToAsset[assetInfo.Name] = asset
-		if _, exists := info.coinToAsset[assetInfo.Name]; !exists {
-			info.coinToAsset[assetInfo.Name] = asset
+	dexMetas = make(map[string]perpDexMeta, len(dexs))
+	for _, dex := range dexs {
+		index, ok := indices[dex]
+		if !ok {
+			missing = append(missing, dex)
+			continue
 		}
-		info.nameToCoin[assetInfo.Name] = assetInfo.Name
-		info.assetToDecimal[asset] = assetInfo.SzDecimals
+
+		meta, err := i.fetchMeta(ctx, dex)
+		if err != nil {
+			return nil, nil, err
+		}
+		dexMetas[dex] = perpDexMeta{index: index, meta: meta}
 	}
 
-	return info
+	return dexMetas, missing, nil
+}
+
+// Refresh re-fetches the perp, spot and loaded perp dex metadata and atomically
+// replaces the asset lookup tables. Listeners registered with OnAssetChange are
+// notified of the assets listed or delisted since the previous refresh. Perp
+// dexes that no longer exist are dropped, their assets being delisted.
+func (i *Info) Refresh(ctx context.Context) error {
+	i.refreshMu.Lock()
+	defer i.refreshMu.Unlock()
+
+	meta, err := i.fetchMeta(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	spotMeta, err := i.fetchSpotMeta(ctx)
+	if err != nil {
+		return err
+	}
+
+	i.mu.RLock()
+	dexs := make([]string, 0, len(i.sources.dexMetas))
+	for dex := range i.sources.dexMetas {
+		dexs = append(dexs, dex)
+	}
+	i.mu.RUnlock()
+
+	dexMetas := make(map[string]perpDexMeta)
+	if len(dexs) > 0 {
+		dexMetas, _, err = i.fetchPerpDexMetas(ctx, dexs)
+		if err != nil {
+			return err
+		}
+	}
+
+	sources := registrySources{meta: meta, spotMeta: spotMeta, dexMetas: dexMetas}
+	registry, err := buildAssetRegistry(sources)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	changes := diffAssetRegistries(i.registry, registry)
+	i.sources, i.registry = sources, registry
+	listeners := make([]func([]AssetChange), 0, len(i.listeners))
+	for _, listener := range i.listeners {
+		listeners = append(listeners, listener)
+	}
+	i.mu.Unlock()
+
+	if len(changes) > 0 {
+		for _, listener := range listeners {
+			listener(changes)
+		}
+	}
+
+	return nil
+}
+
+// StartAutoRefresh refreshes the asset metadata every interval until ctx is
+// done. Refresh errors are logged and retried on the next tick.
+func (i *Info) StartAutoRefresh(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := i.Refresh(ctx); err != nil && ctx.Err() == nil {
+					log.Printf("asset metadata refresh error: %v", err)
+				}
+			}
+		}
+	}()
+}
+
+// OnAssetChange registers a callback invoked after each Refresh that lists or
+// delists assets. It returns an id to pass to RemoveAssetChangeListener.
+func (i *Info) OnAssetChange(callback func([]AssetChange)) int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.nextListener++
+	i.listeners[i.nextListener] = callback
+	return i.nextListener
+}
+
+func (i *Info) RemoveAssetChangeListener(id int) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.listeners, id)
 }
 
 func (i *Info) SpotAsset(name string) (int, bool) {
-	id, ok := i.spotToAsset[name]
+	id, ok := i.assets().spotToAsset[name]
 	return id, ok
 }
 
+// SpotMarket resolves a spot pair by its API name ("PURR/USDC", "@107"), its
+// "BASE/QUOTE" token name or, when unambiguous, its bare base token name.
+func (i *Info) SpotMarket(name string) (SpotMarket, bool) {
+	market, ok := i.assets().spotMarkets.byName[name]
+	return market, ok
+}
+
+// SpotMarketByTokens resolves the spot pair trading base against quote.
+func (i *Info) SpotMarketByTokens(base, quote string) (SpotMarket, bool) {
+	market, ok := i.assets().spotMarkets.byTokens[spotPair{base: base, quote: quote}]
+	return market, ok
+}
+
+// SpotToken returns the spot token named name, the canonical one when several
+// tokens share the name.
+func (i *Info) SpotToken(name string) (SpotTokenInfo, bool) {
+	token, ok := i.assets().spotTokens[name]
+	return token, ok
+}
+
+// SpotTokenID returns the "NAME:tokenId" identifier of a spot token, given its
+// name or identifier.
+func (i *Info) SpotTokenID(token string) (string, error) {
+	registry := i.assets()
+	if strings.Contains(token, spotTokenSeparator) {
+		if info, ok := registry.spotTokenIDs[strings.ToLower(token)]; ok {
+			return info.Identifier(), nil
+		}
+	} else if info, ok := registry.spotTokens[token]; ok {
+		return info.Identifier(), nil
+	}
+
+	return "", ValidationError{Field: "token", Message: fmt.Sprintf("unknown spot token %s", token)}
+}
+
 func (i *Info) PerpAsset(name string) (int, bool) {
-	id, ok := i.perpToAsset[name]
+	id, ok := i.assets().perpToAsset[name]
 	return id, ok
 }
 
+// PerpAssetInfo returns the full perp metadata for a listed asset.
+func (i *Info) PerpAssetInfo(name string) (AssetInfo, bool) {
+	registry := i.assets()
+	id, ok := registry.perpToAsset[name]
+	if !ok {
+		return AssetInfo{}, false
+	}
+	return registry.assetToPerp[id], true
+}
+
+// MarginTable returns the margin table with the given id, as referenced by
+// AssetInfo.MarginTableID.
+func (i *Info) MarginTable(id int) (MarginTable, bool) {
+	return i.DexMarginTable("", id)
+}
+
+// DexMarginTable returns the margin table with the given id of a
+// builder-deployed perp dex. Use an empty dex for the default perp dex.
+func (i *Info) DexMarginTable(dex string, id int) (MarginTable, bool) {
+	table, ok := i.assets().marginTables[marginTableKey{dex: dex, id: id}]
+	return table, ok
+}
+
+// MaxLeverage returns the maximum leverage allowed for a perp position of the
+// given notional value. It falls back to AssetInfo.MaxLeverage when the asset
+// has no known margin table.
+func (i *Info) MaxLeverage(name string, notional float64) (int, bool) {
+	assetInfo, ok := i.PerpAssetInfo(name)
+	if !ok {
+		return 0, false
+	}
+
+	dex, _ := splitPerpDexName(name)
+	table, ok := i.DexMarginTable(dex, assetInfo.MarginTableID)
+	if !ok || len(table.MarginTiers) == 0 {
+		return assetInfo.MaxLeverage, true
+	}
+	return table.MaxLeverageAt(notional), true
+}
+
 func (i *Info) Meta() (*Meta, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	return i.fetchMeta(context.Background(), "")
+}
+
+// DexMeta fetches the meta of a builder-deployed perp dex. An empty dex
+// refers to the default perp dex.
+func (i *Info) DexMeta(dex string) (*Meta, error) {
+	return i.fetchMeta(context.Background(), dex)
+}
+
+func (i *Info) fetchMeta(ctx context.Context, dex string) (*Meta, error) {
+	payload := map[string]any{
 		"type": "meta",
-	})
+	}
+	if dex != "" {
+		payload["dex"] = dex
+	}
+
+	resp, err := i.client.postWithContext(ctx, "/info", payload)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch meta: %w", err)
 	}
@@ -126,8 +405,33 @@ func (i *Info) Meta() (*Meta, error) {
 	return &meta, nil
 }
 
+// PerpDexs lists the perp dexes. The first entry is always nil and stands for
+// the default dex, so that each dex sits at its perp dex index.
+func (i *Info) PerpDexs() ([]*PerpDex, error) {
+	return i.fetchPerpDexs(context.Background())
+}
+
+func (i *Info) fetchPerpDexs(ctx context.Context) ([]*PerpDex, error) {
+	resp, err := i.client.postWithContext(ctx, "/info", map[string]any{
+		"type": "perpDexs",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch perp dexs: %w", err)
+	}
+
+	var result []*PerpDex
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal perp dexs: %w", err)
+	}
+	return result, nil
+}
+
 func (i *Info) SpotMeta() (*SpotMeta, error) {
-	resp, err := i.client.post("/info", map[string]any{
+	return i.fetchSpotMeta(context.Background())
+}
+
+func (i *Info) fetchSpotMeta(ctx context.Context) (*SpotMeta, error) {
+	resp, err := i.client.postWithContext(ctx, "/info", map[string]any{
 		"type": "spotMeta",
 	})
 	if err != nil {
@@ -142,9 +446,24 @@ func (i *Info) SpotMeta() (*SpotMeta, error) {
 	return &spotMeta, nil
 }
 
+// splitPerpDexName splits a "dex:COIN" perp name into its dex and coin. Perps
+// of the default dex have an empty dex.
+func splitPerpDexName(name string) (dex, coin string) {
+	if dex, coin, ok := strings.Cut(name, perpDexSeparator); ok {
+		return dex, coin
+	}
+	return "", name
+}
+
+// nameToCoin returns the coin the API knows the named asset by.
+func (i *Info) nameToCoin(name string) string {
+	return i.assets().nameToCoin[name]
+}
+
 func (i *Info) NameToAsset(name string) int {
-	coin := i.nameToCoin[name]
-	return i.coinToAsset[coin]
+	registry := i.assets()
+	coin := registry.nameToCoin[name]
+	return registry.coinToAsset[coin]
 }
 
 func (i *Info) UserState(address string) (*UserState, error) {
@@ -195,7 +514,10 @@ func (i *Info) OpenOrders(address string) ([]OpenOrder, error) {
 	return result, nil
 }
 
-func (i *Info) FrontendOpenOrders(address string) ([]OpenOrder, error) {
+// FrontendOpenOrders returns the open orders of a user with their trigger and
+// TP/SL details. TP/SL orders attached to a parent order are listed in its
+// Children.
+func (i *Info) FrontendOpenOrders(address string) ([]FrontendOrder, error) {
 	resp, err := i.client.post("/info", map[string]any{
 		"type": "frontendOpenOrders",
 		"user": address,
@@ -204,13 +526,52 @@ func (i *Info) FrontendOpenOrders(address string) ([]OpenOrder, error) {
 		return nil, fmt.Errorf("failed to fetch frontend open orders: %w", err)
 	}
 
-	var result []OpenOrder
+	var result []FrontendOrder
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal frontend open orders: %w", err)
 	}
 	return result, nil
 }
 
+// HistoricalOrders returns the most recent orders of a user along with their
+// final status.
+func (i *Info) HistoricalOrders(address string) ([]OrderWithStatus, error) {
+	resp, err := i.client.post("/info", map[string]any{
+		"type": "historicalOrders",
+		"user": address,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch historical orders: %w", err)
+	}
+
+	var result []OrderWithStatus
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal historical orders: %w", err)
+	}
+	return result, nil
+}
+
+// OrderStatusHistory returns the statuses of an order of a user listed by
+// HistoricalOrders, oldest first. Only the most recent orders of the user are
+// listed, the history of older orders is empty.
+func (i *Info) OrderStatusHistory(user string, oid int64) ([]OrderWithStatus, error) {
+	orders, err := i.HistoricalOrders(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []OrderWithStatus
+	for _, order := range orders {
+		if order.Order.Oid == oid {
+			history = append(history, order)
+		}
+	}
+	slices.SortStableFunc(history, func(a, b OrderWithStatus) int {
+		return cmp.Compare(a.StatusTimestamp, b.StatusTimestamp)
+	})
+	return history, nil
+}
+
 func (i *Info) AllMids() (map[string]string, error) {
 	resp, err := i.client.post("/info", map[string]any{
 		"type": "allMids",
@@ -243,7 +604,38 @@ func (i *Info) UserFills(address string) ([]Fill, error) {
 }
 
 func (i *Info) UserFillsByTime(address string, startTime int64, endTime *int64) ([]Fill, error) {
-	resp, err := i.postTimeRangeRequest("userFillsByTime", address, startTime, endTime, nil)
+	return i.fetchUserFillsByTime(context.Background(), address, startTime, endTime)
+}
+
+// UserFillsByTimeIter walks the fills of a user between startTime and endTime
+// (inclusive, in milliseconds), requesting as many pages as needed. Iteration
+// stops at the first error, which is yielded, including ctx cancellation.
+func (i *Info) UserFillsByTimeIter(
+	ctx context.Context,
+	address string,
+	startTime, endTime int64,
+) iter.Seq2[Fill, error] {
+	return paginateByTime(
+		ctx,
+		startTime,
+		endTime,
+		userFillsPageLimit,
+		func(ctx context.Context, startTime, endTime int64) ([]Fill, error) {
+			return i.fetchUserFillsByTime(ctx, address, startTime, &endTime)
+		},
+		func(fill Fill) (int64, string) {
+			return fill.Time, strconv.FormatInt(fill.Tid, 10)
+		},
+	)
+}
+
+func (i *Info) fetchUserFillsByTime(
+	ctx context.Context,
+	address string,
+	startTime int64,
+	endTime *int64,
+) ([]Fill, error) {
+	resp, err := i.postTimeRangeRequest(ctx, "userFillsByTime", address, startTime, endTime, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -290,8 +682,9 @@ func (i *Info) FundingHistory(
 	startTime int64,
 	endTime *int64,
 ) ([]FundingHistory, error) {
-	coin := i.nameToCoin[name]
+	coin := i.nameToCoin(name)
 	resp, err := i.postTimeRangeRequest(
+		context.Background(),
 		"fundingHistory",
 		"",
 		startTime,
@@ -314,7 +707,40 @@ func (i *Info) UserFundingHistory(
 	startTime int64,
 	endTime *int64,
 ) ([]UserFundingHistory, error) {
-	resp, err := i.postTimeRangeRequest("userFunding", user, startTime, endTime, nil)
+	return i.fetchUserFundingHistory(context.Background(), user, startTime, endTime)
+}
+
+// UserFundingHistoryIter walks the funding payments of a user between
+// startTime and endTime (inclusive, in milliseconds), requesting as many pages
+// as needed. Iteration stops at the first error, which is yielded, including
+// ctx cancellation.
+func (i *Info) UserFundingHistoryIter(
+	ctx context.Context,
+	user string,
+	startTime, endTime int64,
+) iter.Seq2[UserFundingHistory, error] {
+	return paginateByTime(
+		ctx,
+		startTime,
+		endTime,
+		userFundingPageLimit,
+		func(ctx context.Context, startTime, endTime int64) ([]UserFundingHistory, error) {
+			return i.fetchUserFundingHistory(ctx, user, startTime, &endTime)
+		},
+		func(funding UserFundingHistory) (int64, string) {
+			// Funding payments of a user share their hash, tell them apart by coin
+			return funding.Time, funding.Hash + funding.Delta.Coin
+		},
+	)
+}
+
+func (i *Info) fetchUserFundingHistory(
+	ctx context.Context,
+	user string,
+	startTime int64,
+	endTime *int64,
+) ([]UserFundingHistory, error) {
+	resp, err := i.postTimeRangeRequest(ctx, "userFunding", user, startTime, endTime, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -326,43 +752,94 @@ func (i *Info) UserFundingHistory(
 	return result, nil
 }
 
-func (i *Info) L2Snapshot(name string) (*L2Book, error) {
-	resp, err := i.client.post("/info", map[string]any{
-		"type": "l2Book",
-		"coin": i.nameToCoin[name],
-	})
+// UserNonFundingLedgerUpdates returns the deposits, withdrawals, transfers,
+// liquidations and other non-funding balance changes of a user.
+func (i *Info) UserNonFundingLedgerUpdates(
+	user string,
+	startTime int64,
+	endTime *int64,
+) ([]LedgerUpdate, error) {
+	return i.fetchUserNonFundingLedgerUpdates(context.Background(), user, startTime, endTime)
+}
+
+// UserNonFundingLedgerUpdatesIter walks the non-funding ledger updates of a
+// user between startTime and endTime (inclusive, in milliseconds), requesting
+// as many pages as needed. Iteration stops at the first error, which is
+// yielded, including ctx cancellation.
+func (i *Info) UserNonFundingLedgerUpdatesIter(
+	ctx context.Context,
+	user string,
+	startTime, endTime int64,
+) iter.Seq2[LedgerUpdate, error] {
+	return paginateByTime(
+		ctx,
+		startTime,
+		endTime,
+		userLedgerPageLimit,
+		func(ctx context.Context, startTime, endTime int64) ([]LedgerUpdate, error) {
+			return i.fetchUserNonFundingLedgerUpdates(ctx, user, startTime, &endTime)
+		},
+		func(update LedgerUpdate) (int64, string) {
+			key := update.Hash
+			if update.Delta != nil {
+				key += update.Delta.LedgerType()
+			}
+			return update.Time, key
+		},
+	)
+}
+
+func (i *Info) fetchUserNonFundingLedgerUpdates(
+	ctx context.Context,
+	user string,
+	startTime int64,
+	endTime *int64,
+) ([]LedgerUpdate, error) {
+	resp, err := i.postTimeRangeRequest(ctx, "userNonFundingLedgerUpdates", user, startTime, endTime, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch L2 snapshot: %w", err)
+		return nil, err
 	}
 
-	var result L2Book
+	var result []LedgerUpdate
 	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal L2 snapshot: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal ledger updates: %w", err)
 	}
-	return &result, nil
+	return result, nil
+}
+
+func (i *Info) L2Snapshot(name string) (*L2Book, error) {
+	return i.AggregatedL2Snapshot(name, L2BookOptions{})
 }
 
-func (i *Info) CandlesSnapshot(name, interval string, startTime, endTime int64) ([]Candle, error) {
+// AggregatedL2Snapshot returns the L2 book of name with its price levels
+// aggregated as per opts.
+func (i *Info) AggregatedL2Snapshot(name string, opts L2BookOptions) (*L2Book, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
 	req := map[string]any{
-		"coin":      i.nameToCoin[name],
-		"interval":  interval,
-		"startTime": startTime,
-		"endTime":   endTime,
+		"type": "l2Book",
+		"coin": i.nameToCoin(name),
+	}
+	nSigFigs, mantissa := opts.params()
+	if nSigFigs != nil {
+		req["nSigFigs"] = *nSigFigs
+	}
+	if mantissa != nil {
+		req["mantissa"] = *mantissa
 	}
 
-	resp, err := i.client.post("/info", map[string]any{
-		"type": "candleSnapshot",
-		"req":  req,
-	})
+	resp, err := i.client.post("/info", req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch candles snapshot: %w", err)
+		return nil, fmt.Errorf("failed to fetch L2 snapshot: %w", err)
 	}
 
-	var result []Candle
+	var result L2Book
 	if err := json.Unmarshal(resp, &result); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal candles snapshot: %w", err)
+		return nil, fmt.Errorf("failed to unmarshal L2 snapshot: %w", err)
 	}
-	return result, nil
+	return &result, nil
 }
 
 func (i *Info) UserFees(address string) (*UserFees, error) {
@@ -429,7 +906,7 @@ func (i *Info) UserStakingRewards(address string) ([]StakingReward, error) {
 	return result, nil
 }
 
-func (i *Info) QueryOrderByOid(user string, oid int64) (*OpenOrder, error) {
+func (i *Info) QueryOrderByOid(user string, oid int64) (*OrderStatusResponse, error) {
 	resp, err := i.client.post("/info", map[string]any{
 		"type": "orderStatus",
 		"user": user,
@@ -439,14 +916,16 @@ func (i *Info) QueryOrderByOid(user string, oid int64) (*OpenOrder, error) {
 		return nil, fmt.Errorf("failed to fetch order status: %w", err)
 	}
 
-	var result OpenOrder
+	var result OrderStatusResponse
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal order status: %w", err)
 	}
 	return &result, nil
 }
 
-func (i *Info) QueryOrderByCloid(user, cloid string) (*OpenOrder, error) {
+// QueryOrderByCloid queries an order by its client order id, which the API
+// accepts in place of the oid.
+func (i *Info) QueryOrderByCloid(user, cloid string) (*OrderStatusResponse, error) {
 	resp, err := i.client.post("/info", map[string]any{
 		"type": "orderStatus",
 		"user": user,
@@ -456,13 +935,32 @@ func (i *Info) QueryOrderByCloid(user, cloid string) (*OpenOrder, error) {
 		return nil, fmt.Errorf("failed to fetch order status by cloid: %w", err)
 	}
 
-	var result OpenOrder
+	var result OrderStatusResponse
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal order status: %w", err)
 	}
 	return &result, nil
 }
 
+// MaxBuilderFee returns the highest fee user approved builder to charge, in
+// tenths of a basis point.
+func (i *Info) MaxBuilderFee(user, builder string) (int, error) {
+	resp, err := i.client.post("/info", map[string]any{
+		"type":    "maxBuilderFee",
+		"user":    user,
+		"builder": builder,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch max builder fee: %w", err)
+	}
+
+	var result int
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal max builder fee: %w", err)
+	}
+	return result, nil
+}
+
 func (i *Info) QueryReferralState(user string) (*ReferralState, error) {
 	resp, err := i.client.post("/info", map[string]any{
 		"type": "referral",
@@ -0,0 +1,584 @@
+package hyperliquid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMeta() *Meta {
+	return &Meta{
+		Universe: []AssetInfo{
+			{Name: "BTC", SzDecimals: 5, MaxLeverage: 40, MarginTableID: 56},
+			{Name: "MATIC", SzDecimals: 1, MaxLeverage: 20, MarginTableID: 20, IsDelisted: true},
+			{Name: "ETH", SzDecimals: 4, MaxLeverage: 25, MarginTableID: 25},
+		},
+		MarginTables: []MarginTableEntry{
+			{
+				ID: 56,
+				Table: MarginTable{
+					Description: "tiered 40x",
+					MarginTiers: []MarginTier{
+						{LowerBound: 0, MaxLeverage: 40},
+						{LowerBound: 150000000, MaxLeverage: 20},
+					},
+				},
+			},
+		},
+	}
+}
+
+func testSpotMeta() *SpotMeta {
+	return &SpotMeta{
+		Universe: []SpotAssetInfo{
+			{Name: "PURR/USDC", Tokens: []int{1, 0}, Index: 0, IsCanonical: true},
+		},
+		Tokens: []SpotTokenInfo{
+			{Name: "USDC", SzDecimals: 8, WeiDecimals: 8, Index: 0, TokenID: "0x6d1e7cde53ba9467b783cb7c530ce054"},
+			{Name: "PURR", SzDecimals: 0, WeiDecimals: 5, Index: 1, TokenID: "0xc1fb593aeffbeb02f85e0308e9956a90"},
+		},
+	}
+}
+
+func TestNewInfo_PerpMeta(t *testing.T) {
+	info := NewInfo(LocalAPIURL, true, testMeta(), testSpotMeta())
+
+	t.Run("listed_assets", func(t *testing.T) {
+		asset, ok := info.PerpAsset("ETH")
+		require.True(t, ok)
+		assert.Equal(t, 2, asset)
+
+		assetInfo, ok := info.PerpAssetInfo("BTC")
+		require.True(t, ok)
+		assert.Equal(t, 40, assetInfo.MaxLeverage)
+		assert.Equal(t, 56, assetInfo.MarginTableID)
+	})
+
+	t.Run("delisted_assets_excluded", func(t *testing.T) {
+		_, ok := info.PerpAsset("MATIC")
+		assert.False(t, ok)
+		_, ok = info.PerpAssetInfo("MATIC")
+		assert.False(t, ok)
+	})
+
+	t.Run("max_leverage", func(t *testing.T) {
+		leverage, ok := info.MaxLeverage("BTC", 200000000)
+		require.True(t, ok)
+		assert.Equal(t, 20, leverage)
+
+		// ETH has no margin table in the meta, fall back to the asset cap
+		leverage, ok = info.MaxLeverage("ETH", 200000000)
+		require.True(t, ok)
+		assert.Equal(t, 25, leverage)
+
+		_, ok = info.MaxLeverage("MATIC", 0)
+		assert.False(t, ok)
+	})
+}
+
+// newTestServer serves /info requests by passing the decoded request body to
+// respond and encoding whatever it returns.
+func newTestServer(t *testing.T, respond func(req map[string]any) any) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(respond(req))
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestInfo_LoadPerpDexs(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		switch req["type"] {
+		case "perpDexs":
+			return []any{
+				nil,
+				map[string]any{"name": "test", "full_name": "test dex", "deployer": "0x5e89b26d8d66da9888c835c9bfcc2aa51813e152"},
+				map[string]any{"name": "xyz", "full_name": "xyz dex", "deployer": "0x0000000000000000000000000000000000000001"},
+			}
+		case "meta":
+			switch req["dex"] {
+			case "test":
+				return Meta{Universe: []AssetInfo{
+					{Name: "test:ABC", SzDecimals: 0, MaxLeverage: 10},
+					{Name: "test:DEF", SzDecimals: 2, MaxLeverage: 5},
+				}}
+			case "xyz":
+				return Meta{Universe: []AssetInfo{{Name: "XYZ100", SzDecimals: 4, MaxLeverage: 20}}}
+			}
+		}
+		return nil
+	})
+
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	_, ok := info.PerpAsset("test:DEF")
+	require.False(t, ok, "builder dexes are not loaded by default")
+
+	perpDexs, err := info.PerpDexs()
+	require.NoError(t, err)
+	require.Len(t, perpDexs, 3)
+	assert.Nil(t, perpDexs[0])
+	assert.Equal(t, "test dex", perpDexs[1].FullName)
+
+	require.NoError(t, info.LoadPerpDexs("test"))
+
+	asset, ok := info.PerpAsset("test:DEF")
+	require.True(t, ok)
+	assert.Equal(t, 110001, asset)
+	assert.Equal(t, 110001, info.NameToAsset("test:DEF"))
+
+	_, ok = info.PerpAsset("xyz:XYZ100")
+	assert.False(t, ok)
+
+	require.NoError(t, info.LoadPerpDexs())
+
+	asset, ok = info.PerpAsset("xyz:XYZ100")
+	require.True(t, ok)
+	assert.Equal(t, 120000, asset)
+
+	// Default dex assets are unaffected
+	asset, ok = info.PerpAsset("ETH")
+	require.True(t, ok)
+	assert.Equal(t, 2, asset)
+
+	assert.Error(t, info.LoadPerpDexs("unknown"))
+}
+
+func TestInfo_Refresh(t *testing.T) {
+	meta := testMeta()
+	var mu sync.Mutex
+	server := newTestServer(t, func(req map[string]any) any {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch req["type"] {
+		case "meta":
+			return meta
+		case "spotMeta":
+			return testSpotMeta()
+		}
+		return nil
+	})
+
+	info := NewInfo(server.URL, true, nil, nil)
+
+	var changes []AssetChange
+	id := info.OnAssetChange(func(c []AssetChange) {
+		changes = append(changes, c...)
+	})
+
+	_, ok := info.PerpAsset("SOL")
+	require.False(t, ok)
+
+	mu.Lock()
+	meta = testMeta()
+	meta.Universe[0].IsDelisted = true
+	meta.Universe = append(meta.Universe, AssetInfo{Name: "SOL", SzDecimals: 2, MaxLeverage: 20})
+	mu.Unlock()
+
+	require.NoError(t, info.Refresh(context.Background()))
+
+	asset, ok := info.PerpAsset("SOL")
+	require.True(t, ok)
+	assert.Equal(t, 3, asset)
+	_, ok = info.PerpAsset("BTC")
+	assert.False(t, ok)
+
+	assert.Equal(t, []AssetChange{
+		{Type: AssetDelisted, Name: "BTC", Asset: 0},
+		{Type: AssetListed, Name: "SOL", Asset: 3},
+	}, changes)
+
+	// No changes, no notification
+	changes = nil
+	require.NoError(t, info.Refresh(context.Background()))
+	assert.Empty(t, changes)
+
+	info.RemoveAssetChangeListener(id)
+	mu.Lock()
+	meta = testMeta()
+	mu.Unlock()
+	require.NoError(t, info.Refresh(context.Background()))
+	assert.Empty(t, changes)
+}
+
+func TestInfo_RefreshConcurrentLookups(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		switch req["type"] {
+		case "meta":
+			return testMeta()
+		case "spotMeta":
+			return testSpotMeta()
+		}
+		return nil
+	})
+
+	info := NewInfo(server.URL, true, nil, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	info.StartAutoRefresh(ctx, time.Millisecond)
+
+	var wg sync.WaitGroup
+	for n := 0; n < 4; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 100; k++ {
+				_, _ = info.PerpAsset("BTC")
+				_, _ = info.SpotMarket("PURR")
+				_ = info.NameToAsset("ETH")
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for k := 0; k < 5; k++ {
+			assert.NoError(t, info.Refresh(ctx))
+		}
+	}()
+	wg.Wait()
+}
+
+func TestInfo_QueryOrderStatus(t *testing.T) {
+	tests := []struct {
+		fixture string
+		check   func(t *testing.T, resp *OrderStatusResponse)
+	}{
+		{
+			fixture: "order_status_open.json",
+			check: func(t *testing.T, resp *OrderStatusResponse) {
+				require.True(t, resp.Found())
+				assert.Equal(t, OrderStatusOpen, resp.Order.Status)
+				assert.False(t, resp.Order.Status.IsFinal())
+				assert.Equal(t, int64(91490942), resp.Order.Order.Oid)
+				assert.Equal(t, 2412.7, resp.Order.Order.LimitPx)
+				assert.Equal(t, "Gtc", *resp.Order.Order.Tif)
+				assert.Nil(t, resp.Order.Order.Cloid)
+			},
+		},
+		{
+			fixture: "order_status_filled.json",
+			check: func(t *testing.T, resp *OrderStatusResponse) {
+				require.True(t, resp.Found())
+				assert.Equal(t, OrderStatusFilled, resp.Order.Status)
+				assert.True(t, resp.Order.Status.IsFinal())
+				assert.Equal(t, 0.0, resp.Order.Order.Size)
+				assert.Equal(t, 0.015, resp.Order.Order.OrigSz)
+				assert.Equal(t, "0x0000000000000000000000000000cafe", *resp.Order.Order.Cloid)
+			},
+		},
+		{
+			fixture: "order_status_triggered_tpsl.json",
+			check: func(t *testing.T, resp *OrderStatusResponse) {
+				require.True(t, resp.Found())
+				order := resp.Order.Order
+				assert.Equal(t, OrderStatusTriggered, resp.Order.Status)
+				assert.True(t, order.IsTrigger)
+				assert.True(t, order.IsPositionTpsl)
+				assert.True(t, order.ReduceOnly)
+				assert.Equal(t, 140.0, order.TriggerPx)
+				assert.Equal(t, "Price below 140", order.TriggerCondition)
+				assert.Equal(t, "Stop Market", order.OrderType)
+				assert.Nil(t, order.Tif)
+				require.Len(t, order.Children, 1)
+				assert.Equal(t, "Take Profit Market", order.Children[0].OrderType)
+				assert.Equal(t, 121.0, order.Children[0].TriggerPx)
+			},
+		},
+		{
+			fixture: "order_status_margin_canceled.json",
+			check: func(t *testing.T, resp *OrderStatusResponse) {
+				require.True(t, resp.Found())
+				assert.Equal(t, OrderStatusMarginCanceled, resp.Order.Status)
+				assert.True(t, resp.Order.Status.IsCanceled())
+				assert.False(t, resp.Order.Status.IsRejected())
+				assert.Equal(t, int64(1721007001355), resp.Order.StatusTimestamp)
+				assert.Equal(t, time.UnixMilli(1721007001355).UTC(), resp.StatusTime())
+			},
+		},
+		{
+			fixture: "order_status_rejected.json",
+			check: func(t *testing.T, resp *OrderStatusResponse) {
+				require.True(t, resp.Found())
+				assert.Equal(t, OrderStatusBadAloPxRejected, resp.Order.Status)
+				assert.True(t, resp.Order.Status.IsRejected())
+				assert.False(t, resp.Order.Status.IsCanceled())
+			},
+		},
+		{
+			fixture: "order_status_unknown.json",
+			check: func(t *testing.T, resp *OrderStatusResponse) {
+				assert.False(t, resp.Found())
+				assert.Equal(t, "unknownOid", resp.Status)
+				assert.Nil(t, resp.Order)
+				assert.True(t, resp.StatusTime().IsZero())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			fixture, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			require.NoError(t, err)
+
+			var lastReq map[string]any
+			server := newTestServer(t, func(req map[string]any) any {
+				lastReq = req
+				return json.RawMessage(fixture)
+			})
+			info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+			resp, err := info.QueryOrderByOid("0xabc", 91490942)
+			require.NoError(t, err)
+			assert.Equal(t, "orderStatus", lastReq["type"])
+			tt.check(t, resp)
+
+			resp, err = info.QueryOrderByCloid("0xabc", "0x0000000000000000000000000000cafe")
+			require.NoError(t, err)
+			assert.Equal(t, "0x0000000000000000000000000000cafe", lastReq["oid"])
+			tt.check(t, resp)
+		})
+	}
+}
+
+func TestInfo_FrontendOrders(t *testing.T) {
+	fixtures := map[string]string{
+		"frontendOpenOrders": "frontend_open_orders.json",
+		"historicalOrders":   "historical_orders.json",
+	}
+	server := newTestServer(t, func(req map[string]any) any {
+		fixture, err := os.ReadFile(filepath.Join("testdata", fixtures[req["type"].(string)]))
+		require.NoError(t, err)
+		return json.RawMessage(fixture)
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	t.Run("frontend_open_orders", func(t *testing.T) {
+		orders, err := info.FrontendOpenOrders("0xabc")
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+
+		parent := orders[0]
+		assert.Equal(t, "Limit", parent.OrderType)
+		assert.False(t, parent.IsTakeProfit())
+		assert.False(t, parent.IsStopLoss())
+		require.Len(t, parent.Children, 2)
+		assert.True(t, parent.Children[0].IsTakeProfit())
+		assert.Equal(t, 62000.0, parent.Children[0].TriggerPx)
+		assert.True(t, parent.Children[1].IsStopLoss())
+		assert.Equal(t, "Price below 56000", parent.Children[1].TriggerCondition)
+	})
+
+	t.Run("historical_orders", func(t *testing.T) {
+		orders, err := info.HistoricalOrders("0xabc")
+		require.NoError(t, err)
+		require.Len(t, orders, 2)
+
+		assert.Equal(t, OrderStatusFilled, orders[0].Status)
+		assert.Equal(t, 0.5, orders[0].Order.OrigSz)
+
+		assert.Equal(t, OrderStatusSiblingFilledCanceled, orders[1].Status)
+		assert.True(t, orders[1].Status.IsCanceled())
+		assert.True(t, orders[1].Order.IsPositionTpsl)
+		assert.True(t, orders[1].Order.IsStopLoss())
+	})
+}
+
+func TestInfo_OrderStatusHistory(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		assert.Equal(t, "historicalOrders", req["type"])
+		return []OrderWithStatus{
+			{Order: FrontendOrder{Coin: "ETH", Oid: 2}, Status: OrderStatusFilled, StatusTimestamp: 1721900700000},
+			{Order: FrontendOrder{Coin: "ETH", Oid: 1}, Status: OrderStatusCanceled, StatusTimestamp: 1721900600000},
+			{Order: FrontendOrder{Coin: "ETH", Oid: 2}, Status: OrderStatusTriggered, StatusTimestamp: 1721900500000},
+		}
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	history, err := info.OrderStatusHistory("0xabc", 2)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, OrderStatusTriggered, history[0].Status)
+	assert.Equal(t, OrderStatusFilled, history[1].Status)
+	assert.Equal(t, time.UnixMilli(1721900700000).UTC(), history[1].StatusTime())
+
+	history, err = info.OrderStatusHistory("0xabc", 3)
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func TestInfo_UserFillsByTimeIter(t *testing.T) {
+	var requests []map[string]any
+	server := newTestServer(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		startTime := int64(req["startTime"].(float64))
+
+		fills := make([]Fill, 0, userFillsPageLimit)
+		for tid := startTime; tid < 2500 && len(fills) < userFillsPageLimit; tid++ {
+			fills = append(fills, Fill{Coin: "BTC", Time: tid, Tid: tid})
+		}
+		return fills
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	seen := make(map[int64]bool)
+	for fill, err := range info.UserFillsByTimeIter(context.Background(), "0xabc", 0, 5000) {
+		require.NoError(t, err)
+		require.False(t, seen[fill.Tid], "duplicate fill %d", fill.Tid)
+		seen[fill.Tid] = true
+	}
+
+	assert.Len(t, seen, 2500)
+	require.Len(t, requests, 2)
+	assert.Equal(t, "userFillsByTime", requests[0]["type"])
+	assert.Equal(t, float64(1999), requests[1]["startTime"])
+	assert.Equal(t, float64(5000), requests[1]["endTime"])
+}
+
+func TestInfo_UserFundingHistoryIter(t *testing.T) {
+	coins := []string{"BTC", "ETH", "SOL"}
+	zeroHash := "0x0000000000000000000000000000000000000000000000000000000000000000"
+
+	// Funding is paid hourly for every open position, all payments of an hour
+	// sharing a timestamp and the zero hash. 200 hours of 3 coins put the
+	// first page boundary in the middle of an hour.
+	var rows []UserFundingHistory
+	for hour := int64(0); hour < 200; hour++ {
+		for _, coin := range coins {
+			rows = append(rows, UserFundingHistory{
+				Time:  hour * 3600000,
+				Hash:  zeroHash,
+				Delta: FundingDelta{Type: "funding", Coin: coin},
+			})
+		}
+	}
+
+	var requests []map[string]any
+	server := newTestServer(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		startTime := int64(req["startTime"].(float64))
+
+		page := make([]UserFundingHistory, 0, userFundingPageLimit)
+		for _, row := range rows {
+			if row.Time >= startTime && len(page) < userFundingPageLimit {
+				page = append(page, row)
+			}
+		}
+		return page
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	var got []UserFundingHistory
+	for funding, err := range info.UserFundingHistoryIter(context.Background(), "0xabc", 0, 1000*3600000) {
+		require.NoError(t, err)
+		got = append(got, funding)
+	}
+
+	assert.Equal(t, rows, got)
+	require.Len(t, requests, 2)
+	assert.Equal(t, "userFunding", requests[0]["type"])
+	assert.Equal(t, float64(166*3600000), requests[1]["startTime"])
+}
+
+func TestInfo_RefreshPerpDexs(t *testing.T) {
+	var mu sync.Mutex
+	perpDexs := []any{
+		nil,
+		map[string]any{"name": "test", "full_name": "test dex", "deployer": "0x5e89b26d8d66da9888c835c9bfcc2aa51813e152"},
+		map[string]any{"name": "xyz", "full_name": "xyz dex", "deployer": "0x0000000000000000000000000000000000000001"},
+	}
+	refreshing := make(chan struct{})
+	release := make(chan struct{})
+	server := newTestServer(t, func(req map[string]any) any {
+		switch req["type"] {
+		case "perpDexs":
+			mu.Lock()
+			defer mu.Unlock()
+			return perpDexs
+		case "meta":
+			switch req["dex"] {
+			case "test":
+				// Hold the refresh once it has listed the loaded dexes
+				select {
+				case refreshing <- struct{}{}:
+					<-release
+				default:
+				}
+				return Meta{Universe: []AssetInfo{{Name: "test:ABC", SzDecimals: 0, MaxLeverage: 10}}}
+			case "xyz":
+				return Meta{Universe: []AssetInfo{{Name: "xyz:XYZ100", SzDecimals: 4, MaxLeverage: 20}}}
+			}
+			return testMeta()
+		case "spotMeta":
+			return testSpotMeta()
+		}
+		return nil
+	})
+
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+	require.NoError(t, info.LoadPerpDexs("test"))
+
+	t.Run("load_during_refresh", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, info.Refresh(context.Background()))
+		}()
+		<-refreshing
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, info.LoadPerpDexs("xyz"))
+		}()
+		// Give LoadPerpDexs the time to complete before the refresh if it isn't
+		// serialized with it
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		_, ok := info.PerpAsset("xyz:XYZ100")
+		assert.True(t, ok, "the refresh dropped a dex loaded meanwhile")
+		_, ok = info.PerpAsset("test:ABC")
+		assert.True(t, ok)
+	})
+
+	t.Run("dex_removed", func(t *testing.T) {
+		mu.Lock()
+		perpDexs = perpDexs[:2]
+		mu.Unlock()
+
+		var changes []AssetChange
+		info.OnAssetChange(func(c []AssetChange) {
+			changes = append(changes, c...)
+		})
+
+		require.NoError(t, info.Refresh(context.Background()))
+		_, ok := info.PerpAsset("xyz:XYZ100")
+		assert.False(t, ok)
+		_, ok = info.PerpAsset("test:ABC")
+		assert.True(t, ok)
+		assert.Equal(t, []AssetChange{{Type: AssetDelisted, Name: "xyz:XYZ100", Asset: 120000}}, changes)
+
+		// Later refreshes keep working
+		require.NoError(t, info.Refresh(context.Background()))
+	})
+}
@@ -0,0 +1,225 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LedgerUpdate is a non-funding change to the balances of a user. Delta holds
+// one of the Ledger* types, or LedgerUnknown for types this package doesn't
+// know about yet.
+type LedgerUpdate struct {
+	Time  int64
+	Hash  string
+	Delta LedgerDelta
+}
+
+// LedgerDelta is implemented by every ledger update variant.
+type LedgerDelta interface {
+	LedgerType() string
+}
+
+type LedgerDeposit struct {
+	Usdc float64 `json:"usdc,string"`
+}
+
+type LedgerWithdraw struct {
+	Usdc  float64 `json:"usdc,string"`
+	Nonce int64   `json:"nonce"`
+	Fee   float64 `json:"fee,string"`
+}
+
+type LedgerInternalTransfer struct {
+	Usdc        float64 `json:"usdc,string"`
+	User        string  `json:"user"`
+	Destination string  `json:"destination"`
+	Fee         float64 `json:"fee,string"`
+}
+
+type LedgerSubAccountTransfer struct {
+	Usdc        float64 `json:"usdc,string"`
+	User        string  `json:"user"`
+	Destination string  `json:"destination"`
+}
+
+type LedgerSpotTransfer struct {
+	Token          string  `json:"token"`
+	Amount         float64 `json:"amount,string"`
+	UsdcValue      float64 `json:"usdcValue,string"`
+	User           string  `json:"user"`
+	Destination    string  `json:"destination"`
+	Fee            float64 `json:"fee,string"`
+	NativeTokenFee float64 `json:"nativeTokenFee,string"`
+	Nonce          *int64  `json:"nonce"`
+}
+
+// LedgerAccountClassTransfer is a USDC transfer between the perp and spot
+// balances of a user.
+type LedgerAccountClassTransfer struct {
+	Usdc   float64 `json:"usdc,string"`
+	ToPerp bool    `json:"toPerp"`
+}
+
+type LiquidatedPosition struct {
+	Coin string  `json:"coin"`
+	Szi  float64 `json:"szi,string"`
+}
+
+type LedgerLiquidation struct {
+	LiquidatedNtlPos    float64              `json:"liquidatedNtlPos,string"`
+	AccountValue        float64              `json:"accountValue,string"`
+	LeverageType        string               `json:"leverageType"`
+	LiquidatedPositions []LiquidatedPosition `json:"liquidatedPositions"`
+}
+
+type LedgerVaultCreate struct {
+	Vault string  `json:"vault"`
+	Usdc  float64 `json:"usdc,string"`
+	Fee   float64 `json:"fee,string"`
+}
+
+type LedgerVaultDeposit struct {
+	Vault string  `json:"vault"`
+	Usdc  float64 `json:"usdc,string"`
+}
+
+type LedgerVaultWithdraw struct {
+	Vault           string  `json:"vault"`
+	User            string  `json:"user"`
+	RequestedUsd    float64 `json:"requestedUsd,string"`
+	Commission      float64 `json:"commission,string"`
+	ClosingCost     float64 `json:"closingCost,string"`
+	Basis           float64 `json:"basis,string"`
+	NetWithdrawnUsd float64 `json:"netWithdrawnUsd,string"`
+}
+
+type LedgerVaultDistribution struct {
+	Vault string  `json:"vault"`
+	Usdc  float64 `json:"usdc,string"`
+}
+
+type LedgerSpotGenesis struct {
+	Token  string  `json:"token"`
+	Amount float64 `json:"amount,string"`
+}
+
+type LedgerRewardsClaim struct {
+	Amount float64 `json:"amount,string"`
+}
+
+// LedgerCStakingTransfer is a transfer between the spot and staking balances
+// of a user.
+type LedgerCStakingTransfer struct {
+	Token     string  `json:"token"`
+	Amount    float64 `json:"amount,string"`
+	IsDeposit bool    `json:"isDeposit"`
+}
+
+// LedgerUnknown holds ledger updates of a type this package doesn't decode.
+type LedgerUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (LedgerDeposit) LedgerType() string              { return "deposit" }
+func (LedgerWithdraw) LedgerType() string             { return "withdraw" }
+func (LedgerInternalTransfer) LedgerType() string     { return "internalTransfer" }
+func (LedgerSubAccountTransfer) LedgerType() string   { return "subAccountTransfer" }
+func (LedgerSpotTransfer) LedgerType() string         { return "spotTransfer" }
+func (LedgerAccountClassTransfer) LedgerType() string { return "accountClassTransfer" }
+func (LedgerLiquidation) LedgerType() string          { return "liquidation" }
+func (LedgerVaultCreate) LedgerType() string          { return "vaultCreate" }
+func (LedgerVaultDeposit) LedgerType() string         { return "vaultDeposit" }
+func (LedgerVaultWithdraw) LedgerType() string        { return "vaultWithdraw" }
+func (LedgerVaultDistribution) LedgerType() string    { return "vaultDistribution" }
+func (LedgerSpotGenesis) LedgerType() string          { return "spotGenesis" }
+func (LedgerRewardsClaim) LedgerType() string         { return "rewardsClaim" }
+func (LedgerCStakingTransfer) LedgerType() string     { return "cStakingTransfer" }
+func (d LedgerUnknown) LedgerType() string            { return d.Type }
+
+// ledgerDeltaDecoders decodes the delta of each known ledger update type.
+var ledgerDeltaDecoders = map[string]func(json.RawMessage) (LedgerDelta, error){
+	LedgerDeposit{}.LedgerType():              decodeLedgerDelta[LedgerDeposit],
+	LedgerWithdraw{}.LedgerType():             decodeLedgerDelta[LedgerWithdraw],
+	LedgerInternalTransfer{}.LedgerType():     decodeLedgerDelta[LedgerInternalTransfer],
+	LedgerSubAccountTransfer{}.LedgerType():   decodeLedgerDelta[LedgerSubAccountTransfer],
+	LedgerSpotTransfer{}.LedgerType():         decodeLedgerDelta[LedgerSpotTransfer],
+	LedgerAccountClassTransfer{}.LedgerType(): decodeLedgerDelta[LedgerAccountClassTransfer],
+	LedgerLiquidation{}.LedgerType():          decodeLedgerDelta[LedgerLiquidation],
+	LedgerVaultCreate{}.LedgerType():          decodeLedgerDelta[LedgerVaultCreate],
+	LedgerVaultDeposit{}.LedgerType():         decodeLedgerDelta[LedgerVaultDeposit],
+	LedgerVaultWithdraw{}.LedgerType():        decodeLedgerDelta[LedgerVaultWithdraw],
+	LedgerVaultDistribution{}.LedgerType():    decodeLedgerDelta[LedgerVaultDistribution],
+	LedgerSpotGenesis{}.LedgerType():          decodeLedgerDelta[LedgerSpotGenesis],
+	LedgerRewardsClaim{}.LedgerType():         decodeLedgerDelta[LedgerRewardsClaim],
+	LedgerCStakingTransfer{}.LedgerType():     decodeLedgerDelta[LedgerCStakingTransfer],
+}
+
+func decodeLedgerDelta[T LedgerDelta](data json.RawMessage) (LedgerDelta, error) {
+	var delta T
+	if err := json.Unmarshal(data, &delta); err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+type ledgerUpdateJSON struct {
+	Time  int64           `json:"time"`
+	Hash  string          `json:"hash"`
+	Delta json.RawMessage `json:"delta"`
+}
+
+func (u *LedgerUpdate) UnmarshalJSON(data []byte) error {
+	var raw ledgerUpdateJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var tagged struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw.Delta, &tagged); err != nil {
+		return fmt.Errorf("ledger update delta: %w", err)
+	}
+
+	u.Time, u.Hash = raw.Time, raw.Hash
+
+	decode, ok := ledgerDeltaDecoders[tagged.Type]
+	if !ok {
+		u.Delta = LedgerUnknown{Type: tagged.Type, Raw: raw.Delta}
+		return nil
+	}
+
+	delta, err := decode(raw.Delta)
+	if err != nil {
+		return fmt.Errorf("ledger update %s delta: %w", tagged.Type, err)
+	}
+	u.Delta = delta
+
+	return nil
+}
+
+func (u LedgerUpdate) MarshalJSON() ([]byte, error) {
+	var delta json.RawMessage
+	if unknown, ok := u.Delta.(LedgerUnknown); ok {
+		delta = unknown.Raw
+	} else if u.Delta != nil {
+		fields, err := json.Marshal(u.Delta)
+		if err != nil {
+			return nil, err
+		}
+
+		var tagged map[string]json.RawMessage
+		if err := json.Unmarshal(fields, &tagged); err != nil {
+			return nil, err
+		}
+		tagged["type"], _ = json.Marshal(u.Delta.LedgerType())
+
+		delta, err = json.Marshal(tagged)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(ledgerUpdateJSON{Time: u.Time, Hash: u.Hash, Delta: delta})
+}
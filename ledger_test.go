@@ -0,0 +1,72 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadLedgerUpdates(t *testing.T) []LedgerUpdate {
+	t.Helper()
+
+	fixture, err := os.ReadFile(filepath.Join("testdata", "user_non_funding_ledger_updates.json"))
+	require.NoError(t, err)
+
+	var updates []LedgerUpdate
+	require.NoError(t, json.Unmarshal(fixture, &updates))
+	return updates
+}
+
+func TestLedgerUpdate_UnmarshalJSON(t *testing.T) {
+	updates := loadLedgerUpdates(t)
+	require.Len(t, updates, 6)
+
+	assert.Equal(t, int64(1719792000000), updates[0].Time)
+	assert.Equal(t, LedgerDeposit{Usdc: 2500}, updates[0].Delta)
+	assert.Equal(t, LedgerAccountClassTransfer{Usdc: 500, ToPerp: false}, updates[1].Delta)
+
+	transfer, ok := updates[2].Delta.(LedgerSpotTransfer)
+	require.True(t, ok)
+	assert.Equal(t, "PURR", transfer.Token)
+	assert.Equal(t, 185.2, transfer.UsdcValue)
+	assert.Nil(t, transfer.Nonce)
+
+	liquidation, ok := updates[3].Delta.(LedgerLiquidation)
+	require.True(t, ok)
+	assert.Equal(t, []LiquidatedPosition{{Coin: "ETH", Szi: -3.5}}, liquidation.LiquidatedPositions)
+
+	assert.Equal(t, LedgerWithdraw{Usdc: 1000, Nonce: 1719806399000, Fee: 1}, updates[4].Delta)
+
+	unknown, ok := updates[5].Delta.(LedgerUnknown)
+	require.True(t, ok)
+	assert.Equal(t, "borrowLend", unknown.LedgerType())
+	assert.JSONEq(t, `{"type":"borrowLend","token":"USDC","operation":"supply","amount":"100.0"}`, string(unknown.Raw))
+}
+
+func TestLedgerUpdate_UnmarshalJSONInvalidDelta(t *testing.T) {
+	var update LedgerUpdate
+	err := json.Unmarshal([]byte(`{"time":1,"hash":"0x1","delta":{"type":"deposit","usdc":1}}`), &update)
+	assert.ErrorContains(t, err, "ledger update deposit delta")
+}
+
+func TestLedgerUpdate_MarshalJSON(t *testing.T) {
+	for _, update := range loadLedgerUpdates(t) {
+		t.Run(update.Delta.LedgerType(), func(t *testing.T) {
+			data, err := json.Marshal(update)
+			require.NoError(t, err)
+
+			var decoded LedgerUpdate
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, update.Hash, decoded.Hash)
+			if unknown, ok := update.Delta.(LedgerUnknown); ok {
+				assert.JSONEq(t, string(unknown.Raw), string(decoded.Delta.(LedgerUnknown).Raw))
+				return
+			}
+			assert.Equal(t, update.Delta, decoded.Delta)
+		})
+	}
+}
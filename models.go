@@ -1,7 +1,36 @@
 package hyperliquid
 
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
 //go:generate easyjson -all models.go
 
+const (
+	// actionStatusOK is the ActionResponse status of accepted actions
+	actionStatusOK = "ok"
+	// actionStatusErr is the ActionResponse status of rejected actions
+	actionStatusErr = "err"
+)
+
+// ActionResponse is the response of an /exchange request. Response holds the
+// error message of rejected actions.
+type ActionResponse struct {
+	Status   string          `json:"status"`
+	Response json.RawMessage `json:"response"`
+}
+
+// Err returns the error of a rejected action, nil if it was accepted.
+func (r ActionResponse) Err() error {
+	if r.Status == actionStatusOK {
+		return nil
+	}
+
+	return newAPIError(0, r.Response)
+}
+
 type L2Book struct {
 	Coin   string    `json:"coin"`
 	Levels [][]Level `json:"levels"`
@@ -55,11 +84,134 @@ type OpenOrder struct {
 	Coin      string  `json:"coin"`
 	LimitPx   float64 `json:"limitPx,string"`
 	Oid       int64   `json:"oid"`
-	Side      string  `json:"side"`
+	Side      Side    `json:"side"`
 	Size      float64 `json:"sz,string"`
 	Timestamp int64   `json:"timestamp"`
 }
 
+// FrontendOrder is an order with the trigger and TP/SL details shown by the
+// Hyperliquid frontend.
+type FrontendOrder struct {
+	Coin             string          `json:"coin"`
+	Side             Side            `json:"side"`
+	LimitPx          float64         `json:"limitPx,string"`
+	Size             float64         `json:"sz,string"`
+	Oid              int64           `json:"oid"`
+	Timestamp        int64           `json:"timestamp"`
+	TriggerCondition string          `json:"triggerCondition"`
+	IsTrigger        bool            `json:"isTrigger"`
+	TriggerPx        float64         `json:"triggerPx,string"`
+	Children         []FrontendOrder `json:"children"`
+	IsPositionTpsl   bool            `json:"isPositionTpsl"`
+	ReduceOnly       bool            `json:"reduceOnly"`
+	OrderType        string          `json:"orderType"`
+	OrigSz           float64         `json:"origSz,string"`
+	Tif              *string         `json:"tif"`
+	Cloid            *string         `json:"cloid"`
+}
+
+const (
+	orderTypeTakeProfitPrefix = "Take Profit"
+	orderTypeStopPrefix       = "Stop"
+)
+
+// IsTakeProfit reports whether the order is a take profit trigger order.
+func (o FrontendOrder) IsTakeProfit() bool {
+	return o.IsTrigger && strings.HasPrefix(o.OrderType, orderTypeTakeProfitPrefix)
+}
+
+// IsStopLoss reports whether the order is a stop loss trigger order.
+func (o FrontendOrder) IsStopLoss() bool {
+	return o.IsTrigger && strings.HasPrefix(o.OrderType, orderTypeStopPrefix)
+}
+
+type OrderStatus string
+
+const (
+	OrderStatusOpen                                      OrderStatus = "open"
+	OrderStatusFilled                                    OrderStatus = "filled"
+	OrderStatusCanceled                                  OrderStatus = "canceled"
+	OrderStatusTriggered                                 OrderStatus = "triggered"
+	OrderStatusRejected                                  OrderStatus = "rejected"
+	OrderStatusMarginCanceled                            OrderStatus = "marginCanceled"
+	OrderStatusVaultWithdrawalCanceled                   OrderStatus = "vaultWithdrawalCanceled"
+	OrderStatusOpenInterestCapCanceled                   OrderStatus = "openInterestCapCanceled"
+	OrderStatusSelfTradeCanceled                         OrderStatus = "selfTradeCanceled"
+	OrderStatusReduceOnlyCanceled                        OrderStatus = "reduceOnlyCanceled"
+	OrderStatusSiblingFilledCanceled                     OrderStatus = "siblingFilledCanceled"
+	OrderStatusDelistedCanceled                          OrderStatus = "delistedCanceled"
+	OrderStatusLiquidatedCanceled                        OrderStatus = "liquidatedCanceled"
+	OrderStatusScheduledCancel                           OrderStatus = "scheduledCancel"
+	OrderStatusTickRejected                              OrderStatus = "tickRejected"
+	OrderStatusMinTradeNtlRejected                       OrderStatus = "minTradeNtlRejected"
+	OrderStatusPerpMarginRejected                        OrderStatus = "perpMarginRejected"
+	OrderStatusReduceOnlyRejected                        OrderStatus = "reduceOnlyRejected"
+	OrderStatusBadAloPxRejected                          OrderStatus = "badAloPxRejected"
+	OrderStatusIocCancelRejected                         OrderStatus = "iocCancelRejected"
+	OrderStatusBadTriggerPxRejected                      OrderStatus = "badTriggerPxRejected"
+	OrderStatusMarketOrderNoLiquidityRejected            OrderStatus = "marketOrderNoLiquidityRejected"
+	OrderStatusPositionIncreaseAtOpenInterestCapRejected OrderStatus = "positionIncreaseAtOpenInterestCapRejected"
+	OrderStatusPositionFlipAtOpenInterestCapRejected     OrderStatus = "positionFlipAtOpenInterestCapRejected"
+	OrderStatusTooAggressiveAtOpenInterestCapRejected    OrderStatus = "tooAggressiveAtOpenInterestCapRejected"
+	OrderStatusOpenInterestIncreaseRejected              OrderStatus = "openInterestIncreaseRejected"
+	OrderStatusInsufficientSpotBalanceRejected           OrderStatus = "insufficientSpotBalanceRejected"
+	OrderStatusOracleRejected                            OrderStatus = "oracleRejected"
+	OrderStatusPerpMaxPositionRejected                   OrderStatus = "perpMaxPositionRejected"
+)
+
+// IsCanceled reports whether the order was canceled, by the user or by the
+// exchange.
+func (s OrderStatus) IsCanceled() bool {
+	return s == OrderStatusScheduledCancel || strings.HasSuffix(strings.ToLower(string(s)), "canceled")
+}
+
+// IsRejected reports whether the order was rejected when placed.
+func (s OrderStatus) IsRejected() bool {
+	return strings.HasSuffix(strings.ToLower(string(s)), "rejected")
+}
+
+// IsFinal reports whether the order can no longer change status.
+func (s OrderStatus) IsFinal() bool {
+	return s != OrderStatusOpen && s != OrderStatusTriggered
+}
+
+// OrderWithStatus is an order along with its latest status.
+type OrderWithStatus struct {
+	Order           FrontendOrder `json:"order"`
+	Status          OrderStatus   `json:"status"`
+	StatusTimestamp int64         `json:"statusTimestamp"`
+}
+
+// orderStatusFound is the OrderStatusResponse status of known orders, as
+// opposed to "unknownOid".
+const orderStatusFound = "order"
+
+// OrderStatusResponse is the response of an orderStatus query. Order is nil
+// when the order is unknown.
+type OrderStatusResponse struct {
+	Status string           `json:"status"`
+	Order  *OrderWithStatus `json:"order,omitempty"`
+}
+
+// Found reports whether the queried order exists.
+func (r OrderStatusResponse) Found() bool {
+	return r.Status == orderStatusFound && r.Order != nil
+}
+
+// StatusTime returns the time the order reached its latest status, the zero
+// time when the order is unknown.
+func (r OrderStatusResponse) StatusTime() time.Time {
+	if !r.Found() {
+		return time.Time{}
+	}
+	return r.Order.StatusTime()
+}
+
+// StatusTime returns the time the order reached its status.
+func (o OrderWithStatus) StatusTime() time.Time {
+	return time.UnixMilli(o.StatusTimestamp).UTC()
+}
+
 type Fill struct {
 	ClosedPnl     string `json:"closedPnl"`
 	Coin          string `json:"coin"`
@@ -68,10 +220,13 @@ type Fill struct {
 	Hash          string `json:"hash"`
 	Oid           int64  `json:"oid"`
 	Price         string `json:"px"`
-	Side          string `json:"side"`
+	Side          Side   `json:"side"`
 	StartPosition string `json:"startPosition"`
 	Size          string `json:"sz"`
 	Time          int64  `json:"time"`
+	Fee           string `json:"fee"`
+	FeeToken      string `json:"feeToken"`
+	Tid           int64  `json:"tid"`
 }
 
 type FundingHistory struct {
@@ -81,24 +236,37 @@ type FundingHistory struct {
 	Time        int64  `json:"time"`
 }
 
+// UserFundingHistory is a funding payment of a user.
 type UserFundingHistory struct {
-	User      string `json:"user"`
-	Type      string `json:"type"`
-	StartTime int64  `json:"startTime"`
-	EndTime   int64  `json:"endTime"`
+	Time  int64        `json:"time"`
+	Hash  string       `json:"hash"`
+	Delta FundingDelta `json:"delta"`
+}
+
+// FundingDelta is the funding paid, when Usdc is negative, or received for a
+// position of Szi at FundingRate.
+type FundingDelta struct {
+	Type        string  `json:"type"`
+	Coin        string  `json:"coin"`
+	Usdc        float64 `json:"usdc,string"`
+	Szi         float64 `json:"szi,string"`
+	FundingRate float64 `json:"fundingRate,string"`
+	NSamples    *int    `json:"nSamples"`
 }
 
+// Candle is the OHLCV of a coin over an interval. Time is the open time and
+// Timestamp the close time, in milliseconds.
 type Candle struct {
-	Timestamp int64  `json:"T"`
-	Close     string `json:"c"`
-	High      string `json:"h"`
-	Interval  string `json:"i"`
-	Low       string `json:"l"`
-	Number    int    `json:"n"`
-	Open      string `json:"o"`
-	Symbol    string `json:"s"`
-	Time      int64  `json:"t"`
-	Volume    string `json:"v"`
+	Timestamp int64    `json:"T"`
+	Close     float64  `json:"c,string"`
+	High      float64  `json:"h,string"`
+	Interval  Interval `json:"i"`
+	Low       float64  `json:"l,string"`
+	Number    int      `json:"n"`
+	Open      float64  `json:"o,string"`
+	Symbol    string   `json:"s"`
+	Time      int64    `json:"t"`
+	Volume    float64  `json:"v,string"`
 }
 
 type UserFees struct {
@@ -158,16 +326,83 @@ type StakingReward struct {
 	TotalAmount string `json:"totalAmount"`
 }
 
+// ReferralState is the referral program state of a user, both as a referred
+// user and as a referrer. Rewards are in USDC.
 type ReferralState struct {
-	ReferralCode string   `json:"referralCode"`
-	Referrer     string   `json:"referrer"`
-	Referred     []string `json:"referred"`
+	ReferredBy       *ReferredBy      `json:"referredBy"`
+	CumVlm           float64          `json:"cumVlm,string"`
+	UnclaimedRewards float64          `json:"unclaimedRewards,string"`
+	ClaimedRewards   float64          `json:"claimedRewards,string"`
+	BuilderRewards   float64          `json:"builderRewards,string"`
+	ReferrerState    ReferrerState    `json:"referrerState"`
+	RewardHistory    []ReferralReward `json:"rewardHistory"`
+}
+
+// ReferredBy is the referrer of a user and the code it was referred with.
+type ReferredBy struct {
+	Referrer string `json:"referrer"`
+	Code     string `json:"code"`
+}
+
+// ReferrerStage is the stage of a referrer, a user must trade before creating
+// a code and can then refer users once it is registered.
+type ReferrerStage string
+
+const (
+	ReferrerStageReady            ReferrerStage = "ready"
+	ReferrerStageNeedToCreateCode ReferrerStage = "needToCreateCode"
+	ReferrerStageNeedToTrade      ReferrerStage = "needToTrade"
+)
+
+type ReferrerState struct {
+	Stage ReferrerStage     `json:"stage"`
+	Data  ReferrerStateData `json:"data"`
+}
+
+// ReferrerStateData holds the code and referred users of a ready referrer.
+type ReferrerStateData struct {
+	Code           string         `json:"code"`
+	ReferralStates []ReferredUser `json:"referralStates"`
+}
+
+type ReferredUser struct {
+	User                         string  `json:"user"`
+	CumVlm                       float64 `json:"cumVlm,string"`
+	CumRewardedFeesSinceReferred float64 `json:"cumRewardedFeesSinceReferred,string"`
+	CumFeesRewardedToReferrer    float64 `json:"cumFeesRewardedToReferrer,string"`
+	TimeJoined                   int64   `json:"timeJoined"`
 }
 
+type ReferralReward struct {
+	Earned      float64 `json:"earned,string"`
+	Vlm         float64 `json:"vlm,string"`
+	ReferralVlm float64 `json:"referralVlm,string"`
+	Time        int64   `json:"time"`
+}
+
+// SubAccount is a sub-account of Master along with its perp and spot balances.
 type SubAccount struct {
-	Name        string   `json:"name"`
-	User        string   `json:"user"`
-	Permissions []string `json:"permissions"`
+	Name               string                 `json:"name"`
+	User               string                 `json:"subAccountUser"`
+	Master             string                 `json:"master"`
+	Permissions        []string               `json:"permissions"`
+	ClearinghouseState UserState              `json:"clearinghouseState"`
+	SpotState          SpotClearinghouseState `json:"spotState"`
+}
+
+// SpotClearinghouseState holds the spot balances of a user.
+type SpotClearinghouseState struct {
+	Balances []SpotBalance `json:"balances"`
+}
+
+// SpotBalance is the balance of a spot token. Hold is the part of Total
+// reserved by open orders.
+type SpotBalance struct {
+	Coin     string  `json:"coin"`
+	Token    int     `json:"token"`
+	Total    float64 `json:"total,string"`
+	Hold     float64 `json:"hold,string"`
+	EntryNtl float64 `json:"entryNtl,string"`
 }
 
 type MultiSigSigner struct {
@@ -177,7 +412,7 @@ type MultiSigSigner struct {
 
 type Trade struct {
 	Coin  string   `json:"coin"`
-	Side  string   `json:"side"`
+	Side  Side     `json:"side"`
 	Px    string   `json:"px"`
 	Sz    string   `json:"sz"`
 	Time  int64    `json:"time"`
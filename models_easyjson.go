@@ -17,7 +17,7 @@ var (
 	_ easyjson.Marshaler
 )
 
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid(in *jlexer.Lexer, out *VIPTier) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid(in *jlexer.Lexer, out *VIPTier) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -52,7 +52,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid(out *jwriter.Writer, in VIPTier) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid(out *jwriter.Writer, in VIPTier) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -77,27 +77,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid(out *jwriter.Writer, i
 // MarshalJSON supports json.Marshaler interface
 func (v VIPTier) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v VIPTier) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *VIPTier) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *VIPTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out *UserVolume) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid1(in *jlexer.Lexer, out *UserVolume) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -134,7 +134,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid1(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid1(out *jwriter.Writer, in UserVolume) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid1(out *jwriter.Writer, in UserVolume) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -164,27 +164,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid1(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v UserVolume) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid1(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid1(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserVolume) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid1(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid1(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserVolume) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid1(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid1(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserVolume) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid1(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid1(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out *UserState) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid2(in *jlexer.Lexer, out *UserState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -242,7 +242,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid2(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid2(out *jwriter.Writer, in UserState) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid2(out *jwriter.Writer, in UserState) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -283,27 +283,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid2(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v UserState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid2(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserState) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid2(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid2(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserState) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid2(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid2(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out *UserFundingHistory) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid3(in *jlexer.Lexer, out *UserFundingHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -322,14 +322,12 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out
 			continue
 		}
 		switch key {
-		case "user":
-			out.User = string(in.String())
-		case "type":
-			out.Type = string(in.String())
-		case "startTime":
-			out.StartTime = int64(in.Int64())
-		case "endTime":
-			out.EndTime = int64(in.Int64())
+		case "time":
+			out.Time = int64(in.Int64())
+		case "hash":
+			out.Hash = string(in.String())
+		case "delta":
+			(out.Delta).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -340,29 +338,24 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid3(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid3(out *jwriter.Writer, in UserFundingHistory) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid3(out *jwriter.Writer, in UserFundingHistory) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"time\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
+		out.Int64(int64(in.Time))
 	}
 	{
-		const prefix string = ",\"startTime\":"
+		const prefix string = ",\"hash\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.StartTime))
+		out.String(string(in.Hash))
 	}
 	{
-		const prefix string = ",\"endTime\":"
+		const prefix string = ",\"delta\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.EndTime))
+		(in.Delta).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
@@ -370,27 +363,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid3(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v UserFundingHistory) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid3(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserFundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid3(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserFundingHistory) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid3(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserFundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid3(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid3(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out *UserFees) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid4(in *jlexer.Lexer, out *UserFees) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -450,7 +443,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid4(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid4(out *jwriter.Writer, in UserFees) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid4(out *jwriter.Writer, in UserFees) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -496,27 +489,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid4(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v UserFees) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid4(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserFees) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid4(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserFees) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid4(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserFees) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid4(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid4(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out *Trade) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid5(in *jlexer.Lexer, out *Trade) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -538,7 +531,9 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out
 		case "coin":
 			out.Coin = string(in.String())
 		case "side":
-			out.Side = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Side).UnmarshalJSON(data))
+			}
 		case "px":
 			out.Px = string(in.String())
 		case "sz":
@@ -582,7 +577,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid5(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer, in Trade) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid5(out *jwriter.Writer, in Trade) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -594,7 +589,7 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer,
 	{
 		const prefix string = ",\"side\":"
 		out.RawString(prefix)
-		out.String(string(in.Side))
+		out.Raw((in.Side).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"px\":"
@@ -643,27 +638,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid5(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Trade) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid5(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Trade) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid5(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Trade) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid5(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Trade) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid5(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid5(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out *Tiers) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid6(in *jlexer.Lexer, out *Tiers) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -738,7 +733,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid6(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid6(out *jwriter.Writer, in Tiers) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid6(out *jwriter.Writer, in Tiers) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -780,27 +775,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid6(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Tiers) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid6(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Tiers) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid6(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Tiers) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid6(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Tiers) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid6(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid6(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out *SubAccount) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid7(in *jlexer.Lexer, out *SubAccount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -821,8 +816,10 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 		switch key {
 		case "name":
 			out.Name = string(in.String())
-		case "user":
+		case "subAccountUser":
 			out.User = string(in.String())
+		case "master":
+			out.Master = string(in.String())
 		case "permissions":
 			if in.IsNull() {
 				in.Skip()
@@ -846,6 +843,10 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 				}
 				in.Delim(']')
 			}
+		case "clearinghouseState":
+			(out.ClearinghouseState).UnmarshalEasyJSON(in)
+		case "spotState":
+			(out.SpotState).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -856,7 +857,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid7(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer, in SubAccount) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid7(out *jwriter.Writer, in SubAccount) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -866,10 +867,15 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer,
 		out.String(string(in.Name))
 	}
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"subAccountUser\":"
 		out.RawString(prefix)
 		out.String(string(in.User))
 	}
+	{
+		const prefix string = ",\"master\":"
+		out.RawString(prefix)
+		out.String(string(in.Master))
+	}
 	{
 		const prefix string = ",\"permissions\":"
 		out.RawString(prefix)
@@ -886,33 +892,43 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid7(out *jwriter.Writer,
 			out.RawByte(']')
 		}
 	}
+	{
+		const prefix string = ",\"clearinghouseState\":"
+		out.RawString(prefix)
+		(in.ClearinghouseState).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"spotState\":"
+		out.RawString(prefix)
+		(in.SpotState).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v SubAccount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid7(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v SubAccount) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid7(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *SubAccount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid7(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *SubAccount) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid7(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid7(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out *StakingSummary) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid8(in *jlexer.Lexer, out *StakingSummary) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -949,7 +965,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid8(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid8(out *jwriter.Writer, in StakingSummary) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid8(out *jwriter.Writer, in StakingSummary) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -979,27 +995,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid8(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v StakingSummary) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid8(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingSummary) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid8(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingSummary) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid8(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid8(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid8(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out *StakingReward) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid9(in *jlexer.Lexer, out *StakingReward) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1034,7 +1050,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid9(in *jlexer.Lexer, out
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid9(out *jwriter.Writer, in StakingReward) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid9(out *jwriter.Writer, in StakingReward) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1059,27 +1075,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid9(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v StakingReward) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid9(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingReward) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid9(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingReward) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid9(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingReward) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid9(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid9(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, out *StakingDelegation) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid10(in *jlexer.Lexer, out *StakingDelegation) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1114,7 +1130,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid10(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid10(out *jwriter.Writer, in StakingDelegation) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid10(out *jwriter.Writer, in StakingDelegation) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1139,27 +1155,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid10(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v StakingDelegation) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid10(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v StakingDelegation) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid10(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *StakingDelegation) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid10(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *StakingDelegation) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid10(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid10(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, out *ReferralState) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid11(in *jlexer.Lexer, out *SpotClearinghouseState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1178,29 +1194,25 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "referralCode":
-			out.ReferralCode = string(in.String())
-		case "referrer":
-			out.Referrer = string(in.String())
-		case "referred":
+		case "balances":
 			if in.IsNull() {
 				in.Skip()
-				out.Referred = nil
+				out.Balances = nil
 			} else {
 				in.Delim('[')
-				if out.Referred == nil {
+				if out.Balances == nil {
 					if !in.IsDelim(']') {
-						out.Referred = make([]string, 0, 4)
+						out.Balances = make([]SpotBalance, 0, 1)
 					} else {
-						out.Referred = []string{}
+						out.Balances = []SpotBalance{}
 					}
 				} else {
-					out.Referred = (out.Referred)[:0]
+					out.Balances = (out.Balances)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v19 string
-					v19 = string(in.String())
-					out.Referred = append(out.Referred, v19)
+					var v19 SpotBalance
+					(v19).UnmarshalEasyJSON(in)
+					out.Balances = append(out.Balances, v19)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -1215,32 +1227,22 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid11(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid11(out *jwriter.Writer, in ReferralState) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid11(out *jwriter.Writer, in SpotClearinghouseState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"referralCode\":"
+		const prefix string = ",\"balances\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.ReferralCode))
-	}
-	{
-		const prefix string = ",\"referrer\":"
-		out.RawString(prefix)
-		out.String(string(in.Referrer))
-	}
-	{
-		const prefix string = ",\"referred\":"
-		out.RawString(prefix)
-		if in.Referred == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		if in.Balances == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v20, v21 := range in.Referred {
+			for v20, v21 := range in.Balances {
 				if v20 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v21))
+				(v21).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -1249,29 +1251,29 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid11(out *jwriter.Writer,
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v ReferralState) MarshalJSON() ([]byte, error) {
+func (v SpotClearinghouseState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid11(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v ReferralState) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid11(w, v)
+func (v SpotClearinghouseState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *ReferralState) UnmarshalJSON(data []byte) error {
+func (v *SpotClearinghouseState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid11(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *ReferralState) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid11(l, v)
+func (v *SpotClearinghouseState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid11(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, out *Position) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid12(in *jlexer.Lexer, out *SpotBalance) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1292,38 +1294,14 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, ou
 		switch key {
 		case "coin":
 			out.Coin = string(in.String())
-		case "entryPx":
-			if in.IsNull() {
-				in.Skip()
-				out.EntryPx = nil
-			} else {
-				if out.EntryPx == nil {
-					out.EntryPx = new(string)
-				}
-				*out.EntryPx = string(in.String())
-			}
-		case "leverage":
-			(out.Leverage).UnmarshalEasyJSON(in)
-		case "liquidationPx":
-			if in.IsNull() {
-				in.Skip()
-				out.LiquidationPx = nil
-			} else {
-				if out.LiquidationPx == nil {
-					out.LiquidationPx = new(string)
-				}
-				*out.LiquidationPx = string(in.String())
-			}
-		case "marginUsed":
-			out.MarginUsed = string(in.String())
-		case "positionValue":
-			out.PositionValue = string(in.String())
-		case "returnOnEquity":
-			out.ReturnOnEquity = string(in.String())
-		case "szi":
-			out.Szi = string(in.String())
-		case "unrealizedPnl":
-			out.UnrealizedPnl = string(in.String())
+		case "token":
+			out.Token = int(in.Int())
+		case "total":
+			out.Total = float64(in.Float64Str())
+		case "hold":
+			out.Hold = float64(in.Float64Str())
+		case "entryNtl":
+			out.EntryNtl = float64(in.Float64Str())
 		default:
 			in.SkipRecursive()
 		}
@@ -1334,7 +1312,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid12(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid12(out *jwriter.Writer, in Position) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid12(out *jwriter.Writer, in SpotBalance) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1344,80 +1322,52 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid12(out *jwriter.Writer,
 		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"entryPx\":"
-		out.RawString(prefix)
-		if in.EntryPx == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.EntryPx))
-		}
-	}
-	{
-		const prefix string = ",\"leverage\":"
-		out.RawString(prefix)
-		(in.Leverage).MarshalEasyJSON(out)
-	}
-	{
-		const prefix string = ",\"liquidationPx\":"
-		out.RawString(prefix)
-		if in.LiquidationPx == nil {
-			out.RawString("null")
-		} else {
-			out.String(string(*in.LiquidationPx))
-		}
-	}
-	{
-		const prefix string = ",\"marginUsed\":"
-		out.RawString(prefix)
-		out.String(string(in.MarginUsed))
-	}
-	{
-		const prefix string = ",\"positionValue\":"
+		const prefix string = ",\"token\":"
 		out.RawString(prefix)
-		out.String(string(in.PositionValue))
+		out.Int(int(in.Token))
 	}
 	{
-		const prefix string = ",\"returnOnEquity\":"
+		const prefix string = ",\"total\":"
 		out.RawString(prefix)
-		out.String(string(in.ReturnOnEquity))
+		out.Float64Str(float64(in.Total))
 	}
 	{
-		const prefix string = ",\"szi\":"
+		const prefix string = ",\"hold\":"
 		out.RawString(prefix)
-		out.String(string(in.Szi))
+		out.Float64Str(float64(in.Hold))
 	}
 	{
-		const prefix string = ",\"unrealizedPnl\":"
+		const prefix string = ",\"entryNtl\":"
 		out.RawString(prefix)
-		out.String(string(in.UnrealizedPnl))
+		out.Float64Str(float64(in.EntryNtl))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Position) MarshalJSON() ([]byte, error) {
+func (v SpotBalance) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid12(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid12(w, v)
+func (v SpotBalance) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Position) UnmarshalJSON(data []byte) error {
+func (v *SpotBalance) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid12(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid12(l, v)
+func (v *SpotBalance) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid12(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, out *OpenOrder) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid13(in *jlexer.Lexer, out *ReferrerStateData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1436,18 +1386,31 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "coin":
-			out.Coin = string(in.String())
-		case "limitPx":
-			out.LimitPx = float64(in.Float64Str())
-		case "oid":
-			out.Oid = int64(in.Int64())
-		case "side":
-			out.Side = string(in.String())
-		case "sz":
-			out.Size = float64(in.Float64Str())
-		case "timestamp":
-			out.Timestamp = int64(in.Int64())
+		case "code":
+			out.Code = string(in.String())
+		case "referralStates":
+			if in.IsNull() {
+				in.Skip()
+				out.ReferralStates = nil
+			} else {
+				in.Delim('[')
+				if out.ReferralStates == nil {
+					if !in.IsDelim(']') {
+						out.ReferralStates = make([]ReferredUser, 0, 1)
+					} else {
+						out.ReferralStates = []ReferredUser{}
+					}
+				} else {
+					out.ReferralStates = (out.ReferralStates)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 ReferredUser
+					(v22).UnmarshalEasyJSON(in)
+					out.ReferralStates = append(out.ReferralStates, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1458,67 +1421,58 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid13(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid13(out *jwriter.Writer, in OpenOrder) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid13(out *jwriter.Writer, in ReferrerStateData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Coin))
-	}
-	{
-		const prefix string = ",\"limitPx\":"
-		out.RawString(prefix)
-		out.Float64Str(float64(in.LimitPx))
-	}
-	{
-		const prefix string = ",\"oid\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Oid))
-	}
-	{
-		const prefix string = ",\"side\":"
-		out.RawString(prefix)
-		out.String(string(in.Side))
-	}
-	{
-		const prefix string = ",\"sz\":"
-		out.RawString(prefix)
-		out.Float64Str(float64(in.Size))
+		out.String(string(in.Code))
 	}
 	{
-		const prefix string = ",\"timestamp\":"
+		const prefix string = ",\"referralStates\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Timestamp))
+		if in.ReferralStates == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v23, v24 := range in.ReferralStates {
+				if v23 > 0 {
+					out.RawByte(',')
+				}
+				(v24).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v OpenOrder) MarshalJSON() ([]byte, error) {
+func (v ReferrerStateData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid13(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v OpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid13(w, v)
+func (v ReferrerStateData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *OpenOrder) UnmarshalJSON(data []byte) error {
+func (v *ReferrerStateData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid13(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *OpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid13(l, v)
+func (v *ReferrerStateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid13(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, out *MultiSigSigner) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid14(in *jlexer.Lexer, out *ReferrerState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1537,10 +1491,10 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "user":
-			out.User = string(in.String())
-		case "threshold":
-			out.Threshold = int(in.Int())
+		case "stage":
+			out.Stage = ReferrerStage(in.String())
+		case "data":
+			(out.Data).UnmarshalEasyJSON(in)
 		default:
 			in.SkipRecursive()
 		}
@@ -1551,47 +1505,47 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid14(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid14(out *jwriter.Writer, in MultiSigSigner) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid14(out *jwriter.Writer, in ReferrerState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"user\":"
+		const prefix string = ",\"stage\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.User))
+		out.String(string(in.Stage))
 	}
 	{
-		const prefix string = ",\"threshold\":"
+		const prefix string = ",\"data\":"
 		out.RawString(prefix)
-		out.Int(int(in.Threshold))
+		(in.Data).MarshalEasyJSON(out)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MultiSigSigner) MarshalJSON() ([]byte, error) {
+func (v ReferrerState) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid14(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MultiSigSigner) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid14(w, v)
+func (v ReferrerState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MultiSigSigner) UnmarshalJSON(data []byte) error {
+func (v *ReferrerState) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid14(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MultiSigSigner) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid14(l, v)
+func (v *ReferrerState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid14(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, out *MarginSummary) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid15(in *jlexer.Lexer, out *ReferredUser) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1610,14 +1564,16 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "accountValue":
-			out.AccountValue = string(in.String())
-		case "totalMarginUsed":
-			out.TotalMarginUsed = string(in.String())
-		case "totalNtlPos":
-			out.TotalNtlPos = string(in.String())
-		case "totalRawUsd":
-			out.TotalRawUsd = string(in.String())
+		case "user":
+			out.User = string(in.String())
+		case "cumVlm":
+			out.CumVlm = float64(in.Float64Str())
+		case "cumRewardedFeesSinceReferred":
+			out.CumRewardedFeesSinceReferred = float64(in.Float64Str())
+		case "cumFeesRewardedToReferrer":
+			out.CumFeesRewardedToReferrer = float64(in.Float64Str())
+		case "timeJoined":
+			out.TimeJoined = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1628,57 +1584,62 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid15(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid15(out *jwriter.Writer, in MarginSummary) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid15(out *jwriter.Writer, in ReferredUser) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"accountValue\":"
+		const prefix string = ",\"user\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.AccountValue))
+		out.String(string(in.User))
 	}
 	{
-		const prefix string = ",\"totalMarginUsed\":"
+		const prefix string = ",\"cumVlm\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalMarginUsed))
+		out.Float64Str(float64(in.CumVlm))
 	}
 	{
-		const prefix string = ",\"totalNtlPos\":"
+		const prefix string = ",\"cumRewardedFeesSinceReferred\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalNtlPos))
+		out.Float64Str(float64(in.CumRewardedFeesSinceReferred))
 	}
 	{
-		const prefix string = ",\"totalRawUsd\":"
+		const prefix string = ",\"cumFeesRewardedToReferrer\":"
 		out.RawString(prefix)
-		out.String(string(in.TotalRawUsd))
+		out.Float64Str(float64(in.CumFeesRewardedToReferrer))
+	}
+	{
+		const prefix string = ",\"timeJoined\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TimeJoined))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MarginSummary) MarshalJSON() ([]byte, error) {
+func (v ReferredUser) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid15(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MarginSummary) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid15(w, v)
+func (v ReferredUser) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MarginSummary) UnmarshalJSON(data []byte) error {
+func (v *ReferredUser) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid15(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MarginSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid15(l, v)
+func (v *ReferredUser) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid15(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, out *MMTier) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid16(in *jlexer.Lexer, out *ReferredBy) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1697,10 +1658,10 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "add":
-			out.Add = string(in.String())
-		case "makerFractionCutoff":
-			out.MakerFractionCutoff = string(in.String())
+		case "referrer":
+			out.Referrer = string(in.String())
+		case "code":
+			out.Code = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1711,47 +1672,47 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid16(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid16(out *jwriter.Writer, in MMTier) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid16(out *jwriter.Writer, in ReferredBy) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"add\":"
+		const prefix string = ",\"referrer\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Add))
+		out.String(string(in.Referrer))
 	}
 	{
-		const prefix string = ",\"makerFractionCutoff\":"
+		const prefix string = ",\"code\":"
 		out.RawString(prefix)
-		out.String(string(in.MakerFractionCutoff))
+		out.String(string(in.Code))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v MMTier) MarshalJSON() ([]byte, error) {
+func (v ReferredBy) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid16(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v MMTier) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid16(w, v)
+func (v ReferredBy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *MMTier) UnmarshalJSON(data []byte) error {
+func (v *ReferredBy) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid16(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *MMTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid16(l, v)
+func (v *ReferredBy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid16(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, out *Leverage) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid17(in *jlexer.Lexer, out *ReferralState) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1770,19 +1731,48 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "value":
-			out.Value = int(in.Int())
-		case "rawUsd":
+		case "referredBy":
 			if in.IsNull() {
 				in.Skip()
-				out.RawUsd = nil
+				out.ReferredBy = nil
 			} else {
-				if out.RawUsd == nil {
-					out.RawUsd = new(string)
+				if out.ReferredBy == nil {
+					out.ReferredBy = new(ReferredBy)
 				}
-				*out.RawUsd = string(in.String())
+				(*out.ReferredBy).UnmarshalEasyJSON(in)
+			}
+		case "cumVlm":
+			out.CumVlm = float64(in.Float64Str())
+		case "unclaimedRewards":
+			out.UnclaimedRewards = float64(in.Float64Str())
+		case "claimedRewards":
+			out.ClaimedRewards = float64(in.Float64Str())
+		case "builderRewards":
+			out.BuilderRewards = float64(in.Float64Str())
+		case "referrerState":
+			(out.ReferrerState).UnmarshalEasyJSON(in)
+		case "rewardHistory":
+			if in.IsNull() {
+				in.Skip()
+				out.RewardHistory = nil
+			} else {
+				in.Delim('[')
+				if out.RewardHistory == nil {
+					if !in.IsDelim(']') {
+						out.RewardHistory = make([]ReferralReward, 0, 2)
+					} else {
+						out.RewardHistory = []ReferralReward{}
+					}
+				} else {
+					out.RewardHistory = (out.RewardHistory)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v25 ReferralReward
+					(v25).UnmarshalEasyJSON(in)
+					out.RewardHistory = append(out.RewardHistory, v25)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -1794,12 +1784,865 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid17(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer, in Leverage) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid17(out *jwriter.Writer, in ReferralState) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"referredBy\":"
+		out.RawString(prefix[1:])
+		if in.ReferredBy == nil {
+			out.RawString("null")
+		} else {
+			(*in.ReferredBy).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"cumVlm\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.CumVlm))
+	}
+	{
+		const prefix string = ",\"unclaimedRewards\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.UnclaimedRewards))
+	}
+	{
+		const prefix string = ",\"claimedRewards\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.ClaimedRewards))
+	}
+	{
+		const prefix string = ",\"builderRewards\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.BuilderRewards))
+	}
+	{
+		const prefix string = ",\"referrerState\":"
+		out.RawString(prefix)
+		(in.ReferrerState).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"rewardHistory\":"
+		out.RawString(prefix)
+		if in.RewardHistory == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.RewardHistory {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				(v27).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReferralState) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReferralState) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReferralState) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReferralState) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid17(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid18(in *jlexer.Lexer, out *ReferralReward) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "earned":
+			out.Earned = float64(in.Float64Str())
+		case "vlm":
+			out.Vlm = float64(in.Float64Str())
+		case "referralVlm":
+			out.ReferralVlm = float64(in.Float64Str())
+		case "time":
+			out.Time = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid18(out *jwriter.Writer, in ReferralReward) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"earned\":"
+		out.RawString(prefix[1:])
+		out.Float64Str(float64(in.Earned))
+	}
+	{
+		const prefix string = ",\"vlm\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.Vlm))
+	}
+	{
+		const prefix string = ",\"referralVlm\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.ReferralVlm))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReferralReward) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid18(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReferralReward) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid18(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReferralReward) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid18(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReferralReward) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid18(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid19(in *jlexer.Lexer, out *Position) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coin":
+			out.Coin = string(in.String())
+		case "entryPx":
+			if in.IsNull() {
+				in.Skip()
+				out.EntryPx = nil
+			} else {
+				if out.EntryPx == nil {
+					out.EntryPx = new(string)
+				}
+				*out.EntryPx = string(in.String())
+			}
+		case "leverage":
+			(out.Leverage).UnmarshalEasyJSON(in)
+		case "liquidationPx":
+			if in.IsNull() {
+				in.Skip()
+				out.LiquidationPx = nil
+			} else {
+				if out.LiquidationPx == nil {
+					out.LiquidationPx = new(string)
+				}
+				*out.LiquidationPx = string(in.String())
+			}
+		case "marginUsed":
+			out.MarginUsed = string(in.String())
+		case "positionValue":
+			out.PositionValue = string(in.String())
+		case "returnOnEquity":
+			out.ReturnOnEquity = string(in.String())
+		case "szi":
+			out.Szi = string(in.String())
+		case "unrealizedPnl":
+			out.UnrealizedPnl = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid19(out *jwriter.Writer, in Position) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"entryPx\":"
+		out.RawString(prefix)
+		if in.EntryPx == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.EntryPx))
+		}
+	}
+	{
+		const prefix string = ",\"leverage\":"
+		out.RawString(prefix)
+		(in.Leverage).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"liquidationPx\":"
+		out.RawString(prefix)
+		if in.LiquidationPx == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.LiquidationPx))
+		}
+	}
+	{
+		const prefix string = ",\"marginUsed\":"
+		out.RawString(prefix)
+		out.String(string(in.MarginUsed))
+	}
+	{
+		const prefix string = ",\"positionValue\":"
+		out.RawString(prefix)
+		out.String(string(in.PositionValue))
+	}
+	{
+		const prefix string = ",\"returnOnEquity\":"
+		out.RawString(prefix)
+		out.String(string(in.ReturnOnEquity))
+	}
+	{
+		const prefix string = ",\"szi\":"
+		out.RawString(prefix)
+		out.String(string(in.Szi))
+	}
+	{
+		const prefix string = ",\"unrealizedPnl\":"
+		out.RawString(prefix)
+		out.String(string(in.UnrealizedPnl))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Position) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid19(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Position) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid19(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Position) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid19(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Position) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid19(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid20(in *jlexer.Lexer, out *OrderWithStatus) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "order":
+			(out.Order).UnmarshalEasyJSON(in)
+		case "status":
+			out.Status = OrderStatus(in.String())
+		case "statusTimestamp":
+			out.StatusTimestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid20(out *jwriter.Writer, in OrderWithStatus) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"order\":"
+		out.RawString(prefix[1:])
+		(in.Order).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"statusTimestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.StatusTimestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderWithStatus) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid20(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderWithStatus) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid20(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderWithStatus) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid20(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderWithStatus) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid20(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid21(in *jlexer.Lexer, out *OrderStatusResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "order":
+			if in.IsNull() {
+				in.Skip()
+				out.Order = nil
+			} else {
+				if out.Order == nil {
+					out.Order = new(OrderWithStatus)
+				}
+				(*out.Order).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid21(out *jwriter.Writer, in OrderStatusResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	if in.Order != nil {
+		const prefix string = ",\"order\":"
+		out.RawString(prefix)
+		(*in.Order).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OrderStatusResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid21(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OrderStatusResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid21(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OrderStatusResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid21(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OrderStatusResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid21(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid22(in *jlexer.Lexer, out *OpenOrder) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coin":
+			out.Coin = string(in.String())
+		case "limitPx":
+			out.LimitPx = float64(in.Float64Str())
+		case "oid":
+			out.Oid = int64(in.Int64())
+		case "side":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Side).UnmarshalJSON(data))
+			}
+		case "sz":
+			out.Size = float64(in.Float64Str())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid22(out *jwriter.Writer, in OpenOrder) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"limitPx\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.LimitPx))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	{
+		const prefix string = ",\"side\":"
+		out.RawString(prefix)
+		out.Raw((in.Side).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"sz\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.Size))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v OpenOrder) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid22(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v OpenOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid22(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *OpenOrder) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid22(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *OpenOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid22(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid23(in *jlexer.Lexer, out *MultiSigSigner) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "user":
+			out.User = string(in.String())
+		case "threshold":
+			out.Threshold = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid23(out *jwriter.Writer, in MultiSigSigner) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.User))
+	}
+	{
+		const prefix string = ",\"threshold\":"
+		out.RawString(prefix)
+		out.Int(int(in.Threshold))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MultiSigSigner) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MultiSigSigner) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MultiSigSigner) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MultiSigSigner) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid23(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid24(in *jlexer.Lexer, out *MarginSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "accountValue":
+			out.AccountValue = string(in.String())
+		case "totalMarginUsed":
+			out.TotalMarginUsed = string(in.String())
+		case "totalNtlPos":
+			out.TotalNtlPos = string(in.String())
+		case "totalRawUsd":
+			out.TotalRawUsd = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid24(out *jwriter.Writer, in MarginSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"accountValue\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AccountValue))
+	}
+	{
+		const prefix string = ",\"totalMarginUsed\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalMarginUsed))
+	}
+	{
+		const prefix string = ",\"totalNtlPos\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalNtlPos))
+	}
+	{
+		const prefix string = ",\"totalRawUsd\":"
+		out.RawString(prefix)
+		out.String(string(in.TotalRawUsd))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid24(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid24(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid25(in *jlexer.Lexer, out *MMTier) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "add":
+			out.Add = string(in.String())
+		case "makerFractionCutoff":
+			out.MakerFractionCutoff = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid25(out *jwriter.Writer, in MMTier) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"add\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Add))
+	}
+	{
+		const prefix string = ",\"makerFractionCutoff\":"
+		out.RawString(prefix)
+		out.String(string(in.MakerFractionCutoff))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MMTier) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MMTier) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MMTier) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MMTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid25(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid26(in *jlexer.Lexer, out *Leverage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "value":
+			out.Value = int(in.Int())
+		case "rawUsd":
+			if in.IsNull() {
+				in.Skip()
+				out.RawUsd = nil
+			} else {
+				if out.RawUsd == nil {
+					out.RawUsd = new(string)
+				}
+				*out.RawUsd = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid26(out *jwriter.Writer, in Leverage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
 		out.String(string(in.Type))
 	}
@@ -1819,27 +2662,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid17(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Leverage) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid17(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid26(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Leverage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid17(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid26(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Leverage) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid17(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid26(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Leverage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid17(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid26(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, out *Level) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid27(in *jlexer.Lexer, out *Level) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1874,7 +2717,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid18(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid18(out *jwriter.Writer, in Level) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid27(out *jwriter.Writer, in Level) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1899,27 +2742,258 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid18(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Level) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid18(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid27(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Level) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid18(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid27(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Level) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid18(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid27(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Level) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid18(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid27(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid28(in *jlexer.Lexer, out *L2Book) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coin":
+			out.Coin = string(in.String())
+		case "levels":
+			if in.IsNull() {
+				in.Skip()
+				out.Levels = nil
+			} else {
+				in.Delim('[')
+				if out.Levels == nil {
+					if !in.IsDelim(']') {
+						out.Levels = make([][]Level, 0, 2)
+					} else {
+						out.Levels = [][]Level{}
+					}
+				} else {
+					out.Levels = (out.Levels)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 []Level
+					if in.IsNull() {
+						in.Skip()
+						v28 = nil
+					} else {
+						in.Delim('[')
+						if v28 == nil {
+							if !in.IsDelim(']') {
+								v28 = make([]Level, 0, 2)
+							} else {
+								v28 = []Level{}
+							}
+						} else {
+							v28 = (v28)[:0]
+						}
+						for !in.IsDelim(']') {
+							var v29 Level
+							(v29).UnmarshalEasyJSON(in)
+							v28 = append(v28, v29)
+							in.WantComma()
+						}
+						in.Delim(']')
+					}
+					out.Levels = append(out.Levels, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "time":
+			out.Time = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid28(out *jwriter.Writer, in L2Book) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"levels\":"
+		out.RawString(prefix)
+		if in.Levels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v30, v31 := range in.Levels {
+				if v30 > 0 {
+					out.RawByte(',')
+				}
+				if v31 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+					out.RawString("null")
+				} else {
+					out.RawByte('[')
+					for v32, v33 := range v31 {
+						if v32 > 0 {
+							out.RawByte(',')
+						}
+						(v33).MarshalEasyJSON(out)
+					}
+					out.RawByte(']')
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v L2Book) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v L2Book) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *L2Book) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *L2Book) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid28(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid29(in *jlexer.Lexer, out *FundingHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "coin":
+			out.Coin = string(in.String())
+		case "fundingRate":
+			out.FundingRate = string(in.String())
+		case "premium":
+			out.Premium = string(in.String())
+		case "time":
+			out.Time = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid29(out *jwriter.Writer, in FundingHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Coin))
+	}
+	{
+		const prefix string = ",\"fundingRate\":"
+		out.RawString(prefix)
+		out.String(string(in.FundingRate))
+	}
+	{
+		const prefix string = ",\"premium\":"
+		out.RawString(prefix)
+		out.String(string(in.Premium))
+	}
+	{
+		const prefix string = ",\"time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Time))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v FundingHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v FundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *FundingHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *FundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid29(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, out *L2Book) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid30(in *jlexer.Lexer, out *FundingDelta) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1938,54 +3012,26 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, ou
 			continue
 		}
 		switch key {
+		case "type":
+			out.Type = string(in.String())
 		case "coin":
 			out.Coin = string(in.String())
-		case "levels":
+		case "usdc":
+			out.Usdc = float64(in.Float64Str())
+		case "szi":
+			out.Szi = float64(in.Float64Str())
+		case "fundingRate":
+			out.FundingRate = float64(in.Float64Str())
+		case "nSamples":
 			if in.IsNull() {
 				in.Skip()
-				out.Levels = nil
+				out.NSamples = nil
 			} else {
-				in.Delim('[')
-				if out.Levels == nil {
-					if !in.IsDelim(']') {
-						out.Levels = make([][]Level, 0, 2)
-					} else {
-						out.Levels = [][]Level{}
-					}
-				} else {
-					out.Levels = (out.Levels)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v22 []Level
-					if in.IsNull() {
-						in.Skip()
-						v22 = nil
-					} else {
-						in.Delim('[')
-						if v22 == nil {
-							if !in.IsDelim(']') {
-								v22 = make([]Level, 0, 2)
-							} else {
-								v22 = []Level{}
-							}
-						} else {
-							v22 = (v22)[:0]
-						}
-						for !in.IsDelim(']') {
-							var v23 Level
-							(v23).UnmarshalEasyJSON(in)
-							v22 = append(v22, v23)
-							in.WantComma()
-						}
-						in.Delim(']')
-					}
-					out.Levels = append(out.Levels, v22)
-					in.WantComma()
+				if out.NSamples == nil {
+					out.NSamples = new(int)
 				}
-				in.Delim(']')
+				*out.NSamples = int(in.Int())
 			}
-		case "time":
-			out.Time = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1996,74 +3042,71 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid19(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid19(out *jwriter.Writer, in L2Book) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid30(out *jwriter.Writer, in FundingDelta) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"coin\":"
+		const prefix string = ",\"type\":"
 		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"coin\":"
+		out.RawString(prefix)
 		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"levels\":"
+		const prefix string = ",\"usdc\":"
 		out.RawString(prefix)
-		if in.Levels == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v24, v25 := range in.Levels {
-				if v24 > 0 {
-					out.RawByte(',')
-				}
-				if v25 == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-					out.RawString("null")
-				} else {
-					out.RawByte('[')
-					for v26, v27 := range v25 {
-						if v26 > 0 {
-							out.RawByte(',')
-						}
-						(v27).MarshalEasyJSON(out)
-					}
-					out.RawByte(']')
-				}
-			}
-			out.RawByte(']')
-		}
+		out.Float64Str(float64(in.Usdc))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"szi\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		out.Float64Str(float64(in.Szi))
+	}
+	{
+		const prefix string = ",\"fundingRate\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.FundingRate))
+	}
+	{
+		const prefix string = ",\"nSamples\":"
+		out.RawString(prefix)
+		if in.NSamples == nil {
+			out.RawString("null")
+		} else {
+			out.Int(int(*in.NSamples))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v L2Book) MarshalJSON() ([]byte, error) {
+func (v FundingDelta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid19(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid30(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v L2Book) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid19(w, v)
+func (v FundingDelta) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid30(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *L2Book) UnmarshalJSON(data []byte) error {
+func (v *FundingDelta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid19(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid30(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *L2Book) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid19(l, v)
+func (v *FundingDelta) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid30(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, out *FundingHistory) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid31(in *jlexer.Lexer, out *FrontendOrder) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2084,12 +3127,75 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, ou
 		switch key {
 		case "coin":
 			out.Coin = string(in.String())
-		case "fundingRate":
-			out.FundingRate = string(in.String())
-		case "premium":
-			out.Premium = string(in.String())
-		case "time":
-			out.Time = int64(in.Int64())
+		case "side":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Side).UnmarshalJSON(data))
+			}
+		case "limitPx":
+			out.LimitPx = float64(in.Float64Str())
+		case "sz":
+			out.Size = float64(in.Float64Str())
+		case "oid":
+			out.Oid = int64(in.Int64())
+		case "timestamp":
+			out.Timestamp = int64(in.Int64())
+		case "triggerCondition":
+			out.TriggerCondition = string(in.String())
+		case "isTrigger":
+			out.IsTrigger = bool(in.Bool())
+		case "triggerPx":
+			out.TriggerPx = float64(in.Float64Str())
+		case "children":
+			if in.IsNull() {
+				in.Skip()
+				out.Children = nil
+			} else {
+				in.Delim('[')
+				if out.Children == nil {
+					if !in.IsDelim(']') {
+						out.Children = make([]FrontendOrder, 0, 0)
+					} else {
+						out.Children = []FrontendOrder{}
+					}
+				} else {
+					out.Children = (out.Children)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 FrontendOrder
+					(v34).UnmarshalEasyJSON(in)
+					out.Children = append(out.Children, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "isPositionTpsl":
+			out.IsPositionTpsl = bool(in.Bool())
+		case "reduceOnly":
+			out.ReduceOnly = bool(in.Bool())
+		case "orderType":
+			out.OrderType = string(in.String())
+		case "origSz":
+			out.OrigSz = float64(in.Float64Str())
+		case "tif":
+			if in.IsNull() {
+				in.Skip()
+				out.Tif = nil
+			} else {
+				if out.Tif == nil {
+					out.Tif = new(string)
+				}
+				*out.Tif = string(in.String())
+			}
+		case "cloid":
+			if in.IsNull() {
+				in.Skip()
+				out.Cloid = nil
+			} else {
+				if out.Cloid == nil {
+					out.Cloid = new(string)
+				}
+				*out.Cloid = string(in.String())
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2100,7 +3206,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid20(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid20(out *jwriter.Writer, in FundingHistory) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid31(out *jwriter.Writer, in FrontendOrder) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2110,47 +3216,126 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid20(out *jwriter.Writer,
 		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"fundingRate\":"
+		const prefix string = ",\"side\":"
 		out.RawString(prefix)
-		out.String(string(in.FundingRate))
+		out.Raw((in.Side).MarshalJSON())
 	}
 	{
-		const prefix string = ",\"premium\":"
+		const prefix string = ",\"limitPx\":"
 		out.RawString(prefix)
-		out.String(string(in.Premium))
+		out.Float64Str(float64(in.LimitPx))
 	}
 	{
-		const prefix string = ",\"time\":"
+		const prefix string = ",\"sz\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.Time))
+		out.Float64Str(float64(in.Size))
+	}
+	{
+		const prefix string = ",\"oid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	{
+		const prefix string = ",\"timestamp\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Timestamp))
+	}
+	{
+		const prefix string = ",\"triggerCondition\":"
+		out.RawString(prefix)
+		out.String(string(in.TriggerCondition))
+	}
+	{
+		const prefix string = ",\"isTrigger\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsTrigger))
+	}
+	{
+		const prefix string = ",\"triggerPx\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.TriggerPx))
+	}
+	{
+		const prefix string = ",\"children\":"
+		out.RawString(prefix)
+		if in.Children == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v35, v36 := range in.Children {
+				if v35 > 0 {
+					out.RawByte(',')
+				}
+				(v36).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"isPositionTpsl\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPositionTpsl))
+	}
+	{
+		const prefix string = ",\"reduceOnly\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.ReduceOnly))
+	}
+	{
+		const prefix string = ",\"orderType\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderType))
+	}
+	{
+		const prefix string = ",\"origSz\":"
+		out.RawString(prefix)
+		out.Float64Str(float64(in.OrigSz))
+	}
+	{
+		const prefix string = ",\"tif\":"
+		out.RawString(prefix)
+		if in.Tif == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Tif))
+		}
+	}
+	{
+		const prefix string = ",\"cloid\":"
+		out.RawString(prefix)
+		if in.Cloid == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Cloid))
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v FundingHistory) MarshalJSON() ([]byte, error) {
+func (v FrontendOrder) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid20(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid31(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v FundingHistory) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid20(w, v)
+func (v FrontendOrder) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid31(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *FundingHistory) UnmarshalJSON(data []byte) error {
+func (v *FrontendOrder) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid20(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid31(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *FundingHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid20(l, v)
+func (v *FrontendOrder) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid31(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, out *Fill) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid32(in *jlexer.Lexer, out *Fill) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2184,13 +3369,21 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, ou
 		case "px":
 			out.Price = string(in.String())
 		case "side":
-			out.Side = string(in.String())
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Side).UnmarshalJSON(data))
+			}
 		case "startPosition":
 			out.StartPosition = string(in.String())
 		case "sz":
 			out.Size = string(in.String())
 		case "time":
 			out.Time = int64(in.Int64())
+		case "fee":
+			out.Fee = string(in.String())
+		case "feeToken":
+			out.FeeToken = string(in.String())
+		case "tid":
+			out.Tid = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2201,7 +3394,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid21(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer, in Fill) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid32(out *jwriter.Writer, in Fill) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2243,7 +3436,7 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer,
 	{
 		const prefix string = ",\"side\":"
 		out.RawString(prefix)
-		out.String(string(in.Side))
+		out.Raw((in.Side).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"startPosition\":"
@@ -2260,33 +3453,48 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid21(out *jwriter.Writer,
 		out.RawString(prefix)
 		out.Int64(int64(in.Time))
 	}
+	{
+		const prefix string = ",\"fee\":"
+		out.RawString(prefix)
+		out.String(string(in.Fee))
+	}
+	{
+		const prefix string = ",\"feeToken\":"
+		out.RawString(prefix)
+		out.String(string(in.FeeToken))
+	}
+	{
+		const prefix string = ",\"tid\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Tid))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Fill) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid21(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid32(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Fill) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid21(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid32(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Fill) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid21(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid32(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Fill) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid21(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid32(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, out *FeeSchedule) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid33(in *jlexer.Lexer, out *FeeSchedule) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2323,7 +3531,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid22(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer, in FeeSchedule) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid33(out *jwriter.Writer, in FeeSchedule) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2353,27 +3561,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid22(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v FeeSchedule) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid22(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid33(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v FeeSchedule) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid22(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid33(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *FeeSchedule) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid22(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid33(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *FeeSchedule) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid22(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid33(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, out *Candle) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid34(in *jlexer.Lexer, out *Candle) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2395,23 +3603,23 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, ou
 		case "T":
 			out.Timestamp = int64(in.Int64())
 		case "c":
-			out.Close = string(in.String())
+			out.Close = float64(in.Float64Str())
 		case "h":
-			out.High = string(in.String())
+			out.High = float64(in.Float64Str())
 		case "i":
-			out.Interval = string(in.String())
+			out.Interval = Interval(in.String())
 		case "l":
-			out.Low = string(in.String())
+			out.Low = float64(in.Float64Str())
 		case "n":
 			out.Number = int(in.Int())
 		case "o":
-			out.Open = string(in.String())
+			out.Open = float64(in.Float64Str())
 		case "s":
 			out.Symbol = string(in.String())
 		case "t":
 			out.Time = int64(in.Int64())
 		case "v":
-			out.Volume = string(in.String())
+			out.Volume = float64(in.Float64Str())
 		default:
 			in.SkipRecursive()
 		}
@@ -2422,7 +3630,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid23(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer, in Candle) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid34(out *jwriter.Writer, in Candle) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2434,12 +3642,12 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer,
 	{
 		const prefix string = ",\"c\":"
 		out.RawString(prefix)
-		out.String(string(in.Close))
+		out.Float64Str(float64(in.Close))
 	}
 	{
 		const prefix string = ",\"h\":"
 		out.RawString(prefix)
-		out.String(string(in.High))
+		out.Float64Str(float64(in.High))
 	}
 	{
 		const prefix string = ",\"i\":"
@@ -2449,7 +3657,7 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer,
 	{
 		const prefix string = ",\"l\":"
 		out.RawString(prefix)
-		out.String(string(in.Low))
+		out.Float64Str(float64(in.Low))
 	}
 	{
 		const prefix string = ",\"n\":"
@@ -2459,7 +3667,7 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer,
 	{
 		const prefix string = ",\"o\":"
 		out.RawString(prefix)
-		out.String(string(in.Open))
+		out.Float64Str(float64(in.Open))
 	}
 	{
 		const prefix string = ",\"s\":"
@@ -2474,7 +3682,7 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer,
 	{
 		const prefix string = ",\"v\":"
 		out.RawString(prefix)
-		out.String(string(in.Volume))
+		out.Float64Str(float64(in.Volume))
 	}
 	out.RawByte('}')
 }
@@ -2482,27 +3690,27 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v Candle) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid34(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Candle) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid23(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid34(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Candle) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid23(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid34(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Candle) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid23(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid34(l, v)
 }
-func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, out *AssetPosition) {
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid35(in *jlexer.Lexer, out *AssetPosition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2535,7 +3743,7 @@ func easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid24(in *jlexer.Lexer, ou
 		in.Consumed()
 	}
 }
-func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid24(out *jwriter.Writer, in AssetPosition) {
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid35(out *jwriter.Writer, in AssetPosition) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2555,23 +3763,98 @@ func easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid24(out *jwriter.Writer,
 // MarshalJSON supports json.Marshaler interface
 func (v AssetPosition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid24(&w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid35(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetPosition) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonD2b7633eEncodeGithubComSoniricoGoHyperliquid24(w, v)
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid35(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetPosition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid24(&r, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid35(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetPosition) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonD2b7633eDecodeGithubComSoniricoGoHyperliquid24(l, v)
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid35(l, v)
+}
+func easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid36(in *jlexer.Lexer, out *ActionResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "response":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Response).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid36(out *jwriter.Writer, in ActionResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"response\":"
+		out.RawString(prefix)
+		out.Raw((in.Response).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ActionResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ActionResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonD2b7633eEncodeGithubComWeeaaGoHyperliquid36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ActionResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ActionResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonD2b7633eDecodeGithubComWeeaaGoHyperliquid36(l, v)
 }
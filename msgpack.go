@@ -0,0 +1,197 @@
+package hyperliquid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// packAction returns the msgpack encoding of an action, the way Hyperliquid
+// hashes it. The action is encoded as its JSON form with the order of its
+// keys preserved, so struct fields and ActionFields keep their order while
+// map keys are sorted.
+func packAction(action any) ([]byte, error) {
+	data, err := json.Marshal(action)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal action: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var buf bytes.Buffer
+	if err := packJSONValue(&buf, dec); err != nil {
+		return nil, fmt.Errorf("failed to pack action: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// packJSONValue packs the next JSON value of dec.
+func packJSONValue(buf *bytes.Buffer, dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	switch v := tok.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+	case bool:
+		if v {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case json.Number:
+		return packNumber(buf, v)
+	case string:
+		packString(buf, v)
+	case json.Delim:
+		if v == '{' {
+			return packJSONObject(buf, dec)
+		}
+		return packJSONArray(buf, dec)
+	}
+	return nil
+}
+
+// packJSONObject packs the members of the JSON object whose opening brace
+// was read from dec, in order.
+func packJSONObject(buf *bytes.Buffer, dec *json.Decoder) error {
+	var members bytes.Buffer
+	n := 0
+	for dec.More() {
+		key, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		packString(&members, key.(string))
+		if err := packJSONValue(&members, dec); err != nil {
+			return err
+		}
+		n++
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	packHeader(buf, n, 0x80, 0xde, 0xdf)
+	buf.Write(members.Bytes())
+	return nil
+}
+
+// packJSONArray packs the elements of the JSON array whose opening bracket
+// was read from dec.
+func packJSONArray(buf *bytes.Buffer, dec *json.Decoder) error {
+	var elems bytes.Buffer
+	n := 0
+	for dec.More() {
+		if err := packJSONValue(&elems, dec); err != nil {
+			return err
+		}
+		n++
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+
+	packHeader(buf, n, 0x90, 0xdc, 0xdd)
+	buf.Write(elems.Bytes())
+	return nil
+}
+
+// packHeader writes the header of a map or array of n entries, in its fix,
+// 16 bits or 32 bits form.
+func packHeader(buf *bytes.Buffer, n int, fix, code16, code32 byte) {
+	switch {
+	case n < 16:
+		buf.WriteByte(fix | byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(code16)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(n)))
+	default:
+		buf.WriteByte(code32)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(n)))
+	}
+}
+
+func packString(buf *bytes.Buffer, s string) {
+	n := len(s)
+	switch {
+	case n < 32:
+		buf.WriteByte(0xa0 | byte(n))
+	case n <= math.MaxUint8:
+		buf.WriteByte(0xd9)
+		buf.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(0xda)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(n)))
+	default:
+		buf.WriteByte(0xdb)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(n)))
+	}
+	buf.WriteString(s)
+}
+
+// packNumber packs integers in their smallest form and other numbers as
+// 64 bits floats.
+func packNumber(buf *bytes.Buffer, n json.Number) error {
+	if u, err := strconv.ParseUint(n.String(), 10, 64); err == nil {
+		packUint(buf, u)
+		return nil
+	}
+	if i, err := strconv.ParseInt(n.String(), 10, 64); err == nil {
+		packInt(buf, i)
+		return nil
+	}
+
+	f, err := n.Float64()
+	if err != nil {
+		return err
+	}
+	buf.WriteByte(0xcb)
+	buf.Write(binary.BigEndian.AppendUint64(nil, math.Float64bits(f)))
+	return nil
+}
+
+func packUint(buf *bytes.Buffer, u uint64) {
+	switch {
+	case u < 128:
+		buf.WriteByte(byte(u))
+	case u <= math.MaxUint8:
+		buf.WriteByte(0xcc)
+		buf.WriteByte(byte(u))
+	case u <= math.MaxUint16:
+		buf.WriteByte(0xcd)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(u)))
+	case u <= math.MaxUint32:
+		buf.WriteByte(0xce)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(u)))
+	default:
+		buf.WriteByte(0xcf)
+		buf.Write(binary.BigEndian.AppendUint64(nil, u))
+	}
+}
+
+// packInt packs a negative integer.
+func packInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= -32:
+		buf.WriteByte(byte(i))
+	case i >= math.MinInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(i))
+	case i >= math.MinInt16:
+		buf.WriteByte(0xd1)
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(i)))
+	case i >= math.MinInt32:
+		buf.WriteByte(0xd2)
+		buf.Write(binary.BigEndian.AppendUint32(nil, uint32(i)))
+	default:
+		buf.WriteByte(0xd3)
+		buf.Write(binary.BigEndian.AppendUint64(nil, uint64(i)))
+	}
+}
@@ -0,0 +1,323 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var convertToMultiSigUserFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "signers", Type: "string"},
+	{Name: "nonce", Type: "uint64"},
+}
+
+var sendMultiSigFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "multiSigActionHash", Type: "bytes32"},
+	{Name: "nonce", Type: "uint64"},
+}
+
+var usdSendFields = []EIP712Field{
+	{Name: "hyperliquidChain", Type: "string"},
+	{Name: "destination", Type: "string"},
+	{Name: "amount", Type: "string"},
+	{Name: "time", Type: "uint64"},
+}
+
+// UserSignedAction is a user-signed action, such as a transfer, to be signed
+// by the authorized users of a multi-sig account. Action holds its type then
+// its fields in the order Hyperliquid hashes them, without the chain fields
+// and the nonce that NewMultiSigUserSignedAction adds.
+type UserSignedAction struct {
+	Action      ActionFields
+	NonceField  string
+	Fields      []EIP712Field
+	PrimaryType string
+}
+
+// UsdSendAction builds the user-signed action sending amount USDC to
+// destination.
+func UsdSendAction(destination string, amount float64) (UserSignedAction, error) {
+	if err := validateAddress("destination", destination); err != nil {
+		return UserSignedAction{}, err
+	}
+	if amount <= 0 {
+		return UserSignedAction{}, ValidationError{Field: "amount", Message: "must be positive"}
+	}
+
+	return UserSignedAction{
+		Action: ActionFields{
+			{"type", "usdSend"},
+			{"destination", destination},
+			{"amount", strconv.FormatFloat(amount, 'f', -1, 64)},
+		},
+		NonceField:  "time",
+		Fields:      usdSendFields,
+		PrimaryType: "HyperliquidTransaction:UsdSend",
+	}, nil
+}
+
+// SpotSendAction builds the user-signed action sending amount of a spot token
+// to destination, token being resolved as by SpotSend.
+func (e *Exchange) SpotSendAction(destination, token string, amount float64) (UserSignedAction, error) {
+	if err := validateAddress("destination", destination); err != nil {
+		return UserSignedAction{}, err
+	}
+	if amount <= 0 {
+		return UserSignedAction{}, ValidationError{Field: "amount", Message: "must be positive"}
+	}
+
+	tokenID, err := e.info.SpotTokenID(token)
+	if err != nil {
+		return UserSignedAction{}, err
+	}
+
+	return UserSignedAction{
+		Action: ActionFields{
+			{"type", "spotSend"},
+			{"destination", destination},
+			{"token", tokenID},
+			{"amount", strconv.FormatFloat(amount, 'f', -1, 64)},
+		},
+		NonceField:  "time",
+		Fields:      spotSendFields,
+		PrimaryType: "HyperliquidTransaction:SpotSend",
+	}, nil
+}
+
+// UsdClassTransferAction builds the user-signed action moving amount USDC
+// between the spot and perp balances.
+func UsdClassTransferAction(amount float64, toPerp bool) (UserSignedAction, error) {
+	if amount <= 0 {
+		return UserSignedAction{}, ValidationError{Field: "amount", Message: "must be positive"}
+	}
+
+	return UserSignedAction{
+		Action: ActionFields{
+			{"type", "usdClassTransfer"},
+			{"amount", strconv.FormatFloat(amount, 'f', -1, 64)},
+			{"toPerp", toPerp},
+		},
+		NonceField:  "nonce",
+		Fields:      usdClassTransferFields,
+		PrimaryType: "HyperliquidTransaction:UsdClassTransfer",
+	}, nil
+}
+
+// MultiSigAction is an action of a multi-sig user. It collects the signatures
+// of the authorized users of the account, then is submitted by OuterSigner,
+// one of these users.
+type MultiSigAction struct {
+	MultiSigUser string
+	OuterSigner  string
+	Action       ActionFields
+	// VaultAddress is the vault the multi-sig user acts for, empty for none
+	VaultAddress string
+	Nonce        int64
+	ExpiresAfter *int64
+	Signatures   []Signature
+
+	isMainnet bool
+	// fields and primaryType are set for user-signed actions only
+	fields      []EIP712Field
+	primaryType string
+}
+
+// ConvertToMultiSigUser turns the account of the signer into a multi-sig
+// account controlled by authorizedUsers, threshold of which must sign every
+// action.
+func (e *Exchange) ConvertToMultiSigUser(authorizedUsers []string, threshold int) (*ActionResponse, error) {
+	if len(authorizedUsers) == 0 {
+		return nil, ValidationError{Field: "authorizedUsers", Message: "cannot be empty"}
+	}
+	if threshold < 1 || threshold > len(authorizedUsers) {
+		return nil, ValidationError{
+			Field:   "threshold",
+			Message: fmt.Sprintf("must be between 1 and %d", len(authorizedUsers)),
+		}
+	}
+
+	users := make([]string, len(authorizedUsers))
+	for i, user := range authorizedUsers {
+		if !common.IsHexAddress(user) {
+			return nil, ValidationError{Field: "authorizedUsers", Message: fmt.Sprintf("invalid address %s", user)}
+		}
+		users[i] = strings.ToLower(user)
+	}
+	slices.Sort(users)
+	if len(slices.Compact(users)) != len(authorizedUsers) {
+		return nil, ValidationError{Field: "authorizedUsers", Message: "contains duplicates"}
+	}
+
+	signers, err := json.Marshal(map[string]any{
+		"authorizedUsers": users,
+		"threshold":       threshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := map[string]any{
+		"type":    "convertToMultiSigUser",
+		"signers": string(signers),
+	}
+
+	return e.executeUserSignedAction(action, "nonce", convertToMultiSigUserFields, "HyperliquidTransaction:ConvertToMultiSigUser")
+}
+
+// NewMultiSigAction prepares an L1 action, such as one built by OrderAction,
+// of multiSigUser to be signed by its authorized users and submitted by this
+// Exchange. The action is performed for the vault of the Exchange, if any.
+func (e *Exchange) NewMultiSigAction(multiSigUser string, action ActionFields) *MultiSigAction {
+	nonce := e.nonces.next()
+	return &MultiSigAction{
+		MultiSigUser: strings.ToLower(multiSigUser),
+		OuterSigner:  strings.ToLower(NewPrivateKeySigner(e.privateKey).Address().Hex()),
+		Action:       action,
+		VaultAddress: e.actionVault(action),
+		Nonce:        nonce,
+		ExpiresAfter: e.expiry(nonce),
+		isMainnet:    e.client.baseURL == MainnetAPIURL,
+	}
+}
+
+// NewMultiSigUserSignedAction prepares a user-signed action of multiSigUser,
+// such as a transfer, to be signed by its authorized users and submitted by
+// this Exchange. The chain fields follow the type of the action and the nonce
+// is set last, in its NonceField.
+func (e *Exchange) NewMultiSigUserSignedAction(multiSigUser string, action UserSignedAction) *MultiSigAction {
+	m := e.NewMultiSigAction(multiSigUser, nil)
+	m.VaultAddress = ""
+	m.fields = action.Fields
+	m.primaryType = action.PrimaryType
+
+	chain := testnetChainName
+	if m.isMainnet {
+		chain = mainnetChainName
+	}
+	m.Action = make(ActionFields, 0, len(action.Action)+3)
+	m.Action = append(m.Action, action.Action[:1]...)
+	m.Action = append(m.Action,
+		ActionField{"signatureChainId", hexutil.EncodeUint64(userSignedChainID)},
+		ActionField{"hyperliquidChain", chain},
+	)
+	m.Action = append(m.Action, action.Action[1:]...)
+	m.Action = append(m.Action, ActionField{action.NonceField, m.Nonce})
+
+	return m
+}
+
+// Sign adds the signature of an authorized user of the multi-sig account.
+func (m *MultiSigAction) Sign(signer Signer) error {
+	hash, err := m.innerHash()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signHash(signer, hash)
+	if err != nil {
+		return err
+	}
+
+	m.Signatures = append(m.Signatures, sig)
+	return nil
+}
+
+// innerHash returns the digest authorized users sign, which binds the action
+// to the multi-sig user and the outer signer.
+func (m *MultiSigAction) innerHash() ([]byte, error) {
+	if m.fields == nil {
+		envelope := []any{m.MultiSigUser, m.OuterSigner, m.Action}
+		return l1ActionHash(envelope, m.VaultAddress, m.Nonce, m.ExpiresAfter, m.isMainnet)
+	}
+
+	envelope := m.Action.toMap()
+	envelope["payloadMultiSigUser"] = m.MultiSigUser
+	envelope["outerSigner"] = m.OuterSigner
+
+	fields := make([]EIP712Field, 0, len(m.fields)+2)
+	fields = append(fields, m.fields[0])
+	fields = append(fields,
+		EIP712Field{Name: "payloadMultiSigUser", Type: "address"},
+		EIP712Field{Name: "outerSigner", Type: "address"},
+	)
+	fields = append(fields, m.fields[1:]...)
+
+	return userSignedActionHash(envelope, fields, m.primaryType)
+}
+
+// envelope returns the multiSig action carrying m.
+func (m *MultiSigAction) envelope() ActionFields {
+	return ActionFields{
+		{"type", "multiSig"},
+		{"signatureChainId", hexutil.EncodeUint64(userSignedChainID)},
+		{"signatures", m.packedSignatures()},
+		{"payload", ActionFields{
+			{"multiSigUser", m.MultiSigUser},
+			{"outerSigner", m.OuterSigner},
+			{"action", m.Action},
+		}},
+	}
+}
+
+// packedSignatures returns the signatures of the authorized users with r and
+// s in minimal hex, as the Python SDK puts them in the envelope and its hash.
+func (m *MultiSigAction) packedSignatures() []ActionFields {
+	signatures := make([]ActionFields, len(m.Signatures))
+	for i, sig := range m.Signatures {
+		signatures[i] = ActionFields{
+			{"r", hexutil.EncodeBig(sig.R.Big())},
+			{"s", hexutil.EncodeBig(sig.S.Big())},
+			{"v", sig.V},
+		}
+	}
+	return signatures
+}
+
+// outerHash returns the multiSigActionHash the outer signer signs, the hash
+// of the envelope without its type tag.
+func (m *MultiSigAction) outerHash() ([]byte, error) {
+	return actionHash(m.envelope()[1:], m.VaultAddress, m.Nonce, m.ExpiresAfter)
+}
+
+// SubmitMultiSig signs the multiSig envelope of m as its outer signer and
+// sends it.
+func (e *Exchange) SubmitMultiSig(m *MultiSigAction) (*ActionResponse, error) {
+	if len(m.Signatures) == 0 {
+		return nil, ValidationError{Field: "signatures", Message: "cannot be empty"}
+	}
+	if outerSigner := NewPrivateKeySigner(e.privateKey).Address().Hex(); !strings.EqualFold(outerSigner, m.OuterSigner) {
+		return nil, ValidationError{
+			Field:   "outerSigner",
+			Message: fmt.Sprintf("action was prepared for %s, not %s", m.OuterSigner, outerSigner),
+		}
+	}
+
+	action := m.envelope()
+	hash, err := m.outerHash()
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := map[string]any{
+		"multiSigActionHash": hexutil.Encode(hash),
+		"nonce":              m.Nonce,
+	}
+	sig, err := SignUserSignedAction(e.privateKey, envelope, sendMultiSigFields, "HyperliquidTransaction:SendMultiSig", m.isMainnet)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.postAction(action, m.VaultAddress, sig, m.Nonce, m.ExpiresAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeActionResponse(resp)
+}
@@ -0,0 +1,237 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMultiSigUser = "0x8c967e73e7b15087c42a10d344cff4c96d877f1d"
+
+// recoverTestSigner returns the address that produced sig over hash, sig
+// being a Signature or its JSON form as decoded from a request.
+func recoverTestSigner(t *testing.T, hash []byte, sig any) common.Address {
+	t.Helper()
+
+	data, err := json.Marshal(sig)
+	require.NoError(t, err)
+	var signature Signature
+	require.NoError(t, json.Unmarshal(data, &signature))
+
+	signer, err := recoverSigner(hash, signature)
+	require.NoError(t, err)
+	return signer
+}
+
+func newTestSigner(t *testing.T) *PrivateKeySigner {
+	t.Helper()
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return NewPrivateKeySigner(privateKey)
+}
+
+func TestExchange_ConvertToMultiSigUser(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	users := []string{
+		"0xBBBB000000000000000000000000000000000002",
+		"0xaaaa000000000000000000000000000000000001",
+		"0xcccc000000000000000000000000000000000003",
+	}
+	_, err := exchange.ConvertToMultiSigUser(users, 2)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 1)
+	action := requests[0]["action"].(map[string]any)
+	assert.Equal(t, "convertToMultiSigUser", action["type"])
+	assert.JSONEq(t, `{"authorizedUsers":[
+		"0xaaaa000000000000000000000000000000000001",
+		"0xbbbb000000000000000000000000000000000002",
+		"0xcccc000000000000000000000000000000000003"
+	],"threshold":2}`, action["signers"].(string))
+
+	for _, tt := range []struct {
+		name      string
+		users     []string
+		threshold int
+	}{
+		{name: "no_users", threshold: 1},
+		{name: "threshold_too_high", users: users, threshold: 4},
+		{name: "zero_threshold", users: users, threshold: 0},
+		{name: "invalid_address", users: []string{"0x1234"}, threshold: 1},
+		{name: "duplicates", users: []string{users[0], strings.ToLower(users[0])}, threshold: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := exchange.ConvertToMultiSigUser(tt.users, tt.threshold)
+			var validationErr ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+		})
+	}
+	assert.Len(t, requests, 1)
+}
+
+func TestExchange_SubmitMultiSig(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+	outerSigner := NewPrivateKeySigner(exchange.privateKey)
+	signers := []*PrivateKeySigner{outerSigner, newTestSigner(t)}
+
+	t.Run("l1_action", func(t *testing.T) {
+		requests = nil
+		orderAction, err := exchange.OrderAction([]OrderRequest{{
+			Coin:      "ETH",
+			Side:      SideBid,
+			Size:      1,
+			LimitPx:   2000,
+			OrderType: OrderType{Limit: &LimitOrderType{Tif: "Gtc"}},
+		}}, nil, false)
+		require.NoError(t, err)
+		m := exchange.NewMultiSigAction(testMultiSigUser, orderAction)
+		for _, signer := range signers {
+			require.NoError(t, m.Sign(signer))
+		}
+
+		_, err = exchange.SubmitMultiSig(m)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+
+		req := requests[0]
+		assert.Equal(t, testVaultAddress, m.VaultAddress)
+		assert.Equal(t, testVaultAddress, req["vaultAddress"])
+		action := req["action"].(map[string]any)
+		assert.Equal(t, "multiSig", action["type"])
+		payload := action["payload"].(map[string]any)
+		assert.Equal(t, testMultiSigUser, payload["multiSigUser"])
+		assert.Equal(t, strings.ToLower(outerSigner.Address().Hex()), payload["outerSigner"])
+
+		innerHash, err := m.innerHash()
+		require.NoError(t, err)
+		signatures := action["signatures"].([]any)
+		require.Len(t, signatures, 2)
+		for i, signer := range signers {
+			assert.Equal(t, signer.Address(), recoverTestSigner(t, innerHash, signatures[i]))
+			assert.Equal(t, hexutil.EncodeBig(m.Signatures[i].R.Big()), signatures[i].(map[string]any)["r"])
+		}
+
+		// The outer signature covers the envelope hash TestMultiSigAction_Hashes
+		// pins
+		hash, err := m.outerHash()
+		require.NoError(t, err)
+		outerHash, err := userSignedActionHash(map[string]any{
+			"hyperliquidChain":   "Testnet",
+			"multiSigActionHash": hexutil.Encode(hash),
+			"nonce":              m.Nonce,
+		}, sendMultiSigFields, "HyperliquidTransaction:SendMultiSig")
+		require.NoError(t, err)
+		assert.Equal(t, outerSigner.Address(), recoverTestSigner(t, outerHash, req["signature"]))
+	})
+
+	t.Run("user_signed_action", func(t *testing.T) {
+		requests = nil
+		usdSend, err := UsdSendAction("0x0000000000000000000000000000000000000002", 100)
+		require.NoError(t, err)
+		m := exchange.NewMultiSigUserSignedAction(testMultiSigUser, usdSend)
+		assert.Equal(t, ActionFields{
+			{"type", "usdSend"},
+			{"signatureChainId", "0x66eee"},
+			{"hyperliquidChain", "Testnet"},
+			{"destination", "0x0000000000000000000000000000000000000002"},
+			{"amount", "100"},
+			{"time", m.Nonce},
+		}, m.Action)
+
+		require.NoError(t, m.Sign(signers[1]))
+
+		innerHash, err := userSignedActionHash(map[string]any{
+			"hyperliquidChain":    "Testnet",
+			"payloadMultiSigUser": testMultiSigUser,
+			"outerSigner":         strings.ToLower(outerSigner.Address().Hex()),
+			"destination":         "0x0000000000000000000000000000000000000002",
+			"amount":              "100",
+			"time":                m.Nonce,
+		}, []EIP712Field{
+			{Name: "hyperliquidChain", Type: "string"},
+			{Name: "payloadMultiSigUser", Type: "address"},
+			{Name: "outerSigner", Type: "address"},
+			{Name: "destination", Type: "string"},
+			{Name: "amount", Type: "string"},
+			{Name: "time", Type: "uint64"},
+		}, "HyperliquidTransaction:UsdSend")
+		require.NoError(t, err)
+		assert.Equal(t, signers[1].Address(), recoverTestSigner(t, innerHash, m.Signatures[0]))
+
+		_, err = exchange.SubmitMultiSig(m)
+		require.NoError(t, err)
+		require.Len(t, requests, 1)
+		assert.Equal(t, "", requests[0]["vaultAddress"])
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		requests = nil
+		m := exchange.NewMultiSigAction(testMultiSigUser, ActionFields{{"type", "noop"}})
+		_, err := exchange.SubmitMultiSig(m)
+		assert.Error(t, err)
+
+		m.OuterSigner = "0x0000000000000000000000000000000000000001"
+		require.NoError(t, m.Sign(signers[1]))
+		_, err = exchange.SubmitMultiSig(m)
+		var validationErr ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, "outerSigner", validationErr.Field)
+		assert.Empty(t, requests)
+	})
+}
+
+// TestMultiSigAction_Hashes pins the payloads the multi-sig hashes cover to
+// the dicts the Python SDK builds in sign_multi_sig_l1_action_payload and
+// sign_multi_sig_action, written as JSON in their key order.
+func TestMultiSigAction_Hashes(t *testing.T) {
+	const outerSigner = "0x14791697260e4c9a71f18484c9f997b308e59325"
+	m := &MultiSigAction{
+		MultiSigUser: testMultiSigUser,
+		OuterSigner:  outerSigner,
+		Action: ActionFields{
+			{"type", "updateLeverage"},
+			{"asset", 1},
+			{"isCross", true},
+			{"leverage", 5},
+		},
+		VaultAddress: testVaultAddress,
+		Nonce:        1700000000000,
+		Signatures: []Signature{{
+			R: common.HexToHash("0x01"),
+			S: common.HexToHash("0x02"),
+			V: 27,
+		}},
+	}
+	action := `{"type":"updateLeverage","asset":1,"isCross":true,"leverage":5}`
+
+	innerHash, err := m.innerHash()
+	require.NoError(t, err)
+	want, err := l1ActionHash(json.RawMessage(`["`+testMultiSigUser+`","`+outerSigner+`",`+action+`]`), testVaultAddress, m.Nonce, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, want, innerHash)
+
+	outerHash, err := m.outerHash()
+	require.NoError(t, err)
+	want, err = actionHash(json.RawMessage(`{"signatureChainId":"0x66eee","signatures":[{`+
+		`"r":"0x1","s":"0x2","v":27}],`+
+		`"payload":{"multiSigUser":"`+testMultiSigUser+`","outerSigner":"`+outerSigner+`","action":`+action+`}}`), testVaultAddress, m.Nonce, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want, outerHash)
+}
@@ -0,0 +1,54 @@
+package hyperliquid
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// nonceManager hands out the nonces of a signer. Hyperliquid rejects nonces a
+// signer already used, so they must be unique even when actions are sent in
+// the same millisecond.
+type nonceManager struct {
+	mu   sync.Mutex
+	last int64
+	now  func() int64
+}
+
+var (
+	nonceManagersMu sync.Mutex
+	// nonceManagers holds the nonce manager of each signer, shared by all the
+	// Exchanges signing with its key.
+	nonceManagers = make(map[common.Address]*nonceManager)
+)
+
+func newNonceManager() *nonceManager {
+	return &nonceManager{
+		now: func() int64 { return time.Now().UnixMilli() },
+	}
+}
+
+// nonceManagerFor returns the nonce manager of signer, creating it on first use.
+func nonceManagerFor(signer common.Address) *nonceManager {
+	nonceManagersMu.Lock()
+	defer nonceManagersMu.Unlock()
+
+	manager, ok := nonceManagers[signer]
+	if !ok {
+		manager = newNonceManager()
+		nonceManagers[signer] = manager
+	}
+	return manager
+}
+
+// next returns the current time in milliseconds, or one more than the last
+// nonce when the clock hasn't moved past it, because several nonces were
+// handed out within a millisecond or the clock was set back.
+func (m *nonceManager) next() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.last = max(m.now(), m.last+1)
+	return m.last
+}
@@ -0,0 +1,70 @@
+package hyperliquid
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNonceManager_Next(t *testing.T) {
+	clock := int64(1000)
+	manager := newNonceManager()
+	manager.now = func() int64 { return clock }
+
+	assert.Equal(t, int64(1000), manager.next())
+	assert.Equal(t, int64(1001), manager.next(), "same millisecond")
+
+	clock = 2000
+	assert.Equal(t, int64(2000), manager.next(), "follows the clock")
+
+	clock = 1500
+	assert.Equal(t, int64(2001), manager.next(), "clock set back")
+	assert.Equal(t, int64(2002), manager.next())
+
+	clock = 3000
+	assert.Equal(t, int64(3000), manager.next())
+}
+
+func TestNonceManager_Concurrent(t *testing.T) {
+	manager := newNonceManager()
+	manager.now = func() int64 { return 1000 }
+
+	const goroutines, perGoroutine = 16, 100
+
+	var mu sync.Mutex
+	seen := make(map[int64]bool)
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range perGoroutine {
+				nonce := manager.next()
+				mu.Lock()
+				seen[nonce] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, goroutines*perGoroutine)
+}
+
+func TestNonceManagerFor_SharedByKey(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	first := NewExchange(privateKey, LocalAPIURL, testMeta(), "", "", testSpotMeta())
+	second := NewExchange(privateKey, MainnetAPIURL, testMeta(), "", "", testSpotMeta())
+	other := NewExchange(otherKey, LocalAPIURL, testMeta(), "", "", testSpotMeta())
+
+	assert.Same(t, first.nonces, second.nonces)
+	assert.NotSame(t, first.nonces, other.nonces)
+	assert.Less(t, first.nonces.next(), second.nonces.next())
+}
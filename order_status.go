@@ -0,0 +1,95 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// OrderResult is the status of one order or cancel of an accepted request.
+// Orders are either resting, filled or waiting for their trigger, as told by
+// Status; rejected orders and cancels have an Error.
+type OrderResult struct {
+	// Status is set for the statuses sent as a plain string, such as
+	// "success" for cancels and "waitingForFill" for trigger orders.
+	Status  string        `json:"-"`
+	Resting *RestingOrder `json:"resting,omitempty"`
+	Filled  *FilledOrder  `json:"filled,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+type RestingOrder struct {
+	Oid   int64   `json:"oid"`
+	Cloid *string `json:"cloid,omitempty"`
+}
+
+type FilledOrder struct {
+	TotalSz float64 `json:"totalSz,string"`
+	AvgPx   float64 `json:"avgPx,string"`
+	Oid     int64   `json:"oid"`
+	Cloid   *string `json:"cloid,omitempty"`
+}
+
+func (r *OrderResult) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		*r = OrderResult{}
+		return json.Unmarshal(data, &r.Status)
+	}
+
+	type orderResult OrderResult
+	var result orderResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return err
+	}
+	*r = OrderResult(result)
+	return nil
+}
+
+func (r OrderResult) MarshalJSON() ([]byte, error) {
+	if r.Status != "" {
+		return json.Marshal(r.Status)
+	}
+	type orderResult OrderResult
+	return json.Marshal(orderResult(r))
+}
+
+// Oid returns the id of a resting or filled order, 0 otherwise.
+func (r OrderResult) Oid() int64 {
+	switch {
+	case r.Resting != nil:
+		return r.Resting.Oid
+	case r.Filled != nil:
+		return r.Filled.Oid
+	default:
+		return 0
+	}
+}
+
+// orderStatusesResponse is the response of order and cancel actions, with
+// one status per order or cancel of the request.
+type orderStatusesResponse struct {
+	Response struct {
+		Type string `json:"type"`
+		Data struct {
+			Statuses []OrderResult `json:"statuses"`
+		} `json:"data"`
+	} `json:"response"`
+}
+
+// decodeOrderStatuses returns the statuses of an order or cancel response,
+// along with the OrderError of each rejected order joined.
+func decodeOrderStatuses(resp []byte) ([]OrderResult, error) {
+	var result orderStatusesResponse
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode order statuses: %w", err)
+	}
+
+	statuses := result.Response.Data.Statuses
+	var errs []error
+	for i, status := range statuses {
+		if status.Error != "" {
+			errs = append(errs, OrderError{Index: i, Message: status.Error})
+		}
+	}
+	return statuses, errors.Join(errs...)
+}
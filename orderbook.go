@@ -0,0 +1,67 @@
+package hyperliquid
+
+import "fmt"
+
+const (
+	minL2NSigFigs = 2
+	maxL2NSigFigs = 5
+)
+
+// L2BookOptions aggregates the price levels of an L2 book. NSigFigs rounds
+// prices to 2 to 5 significant figures, zero meaning full precision. Mantissa
+// further coarsens 5 significant figures books and can be 1, 2 or 5.
+type L2BookOptions struct {
+	NSigFigs int
+	Mantissa int
+}
+
+// Validate reports whether the options are a combination Hyperliquid accepts.
+func (o L2BookOptions) Validate() error {
+	if o.NSigFigs != 0 && (o.NSigFigs < minL2NSigFigs || o.NSigFigs > maxL2NSigFigs) {
+		return ValidationError{
+			Field:   "nSigFigs",
+			Message: fmt.Sprintf("must be between %d and %d, got %d", minL2NSigFigs, maxL2NSigFigs, o.NSigFigs),
+		}
+	}
+
+	switch o.Mantissa {
+	case 0:
+	case 1, 2, 5:
+		if o.NSigFigs != maxL2NSigFigs {
+			return ValidationError{
+				Field:   "mantissa",
+				Message: fmt.Sprintf("is only allowed with nSigFigs %d", maxL2NSigFigs),
+			}
+		}
+	default:
+		return ValidationError{
+			Field:   "mantissa",
+			Message: fmt.Sprintf("must be 1, 2 or 5, got %d", o.Mantissa),
+		}
+	}
+
+	return nil
+}
+
+// params returns the request fields of the options, nil for the zero value.
+func (o L2BookOptions) params() (nSigFigs, mantissa *int) {
+	if o.NSigFigs != 0 {
+		nSigFigs = &o.NSigFigs
+	}
+	if o.Mantissa != 0 {
+		mantissa = &o.Mantissa
+	}
+	return nSigFigs, mantissa
+}
+
+// l2BookOptions returns the aggregation options of an l2Book subscription.
+func (s Subscription) l2BookOptions() L2BookOptions {
+	var opts L2BookOptions
+	if s.NSigFigs != nil {
+		opts.NSigFigs = *s.NSigFigs
+	}
+	if s.Mantissa != nil {
+		opts.Mantissa = *s.Mantissa
+	}
+	return opts
+}
@@ -0,0 +1,109 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestL2BookOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    L2BookOptions
+		wantErr string
+	}{
+		{name: "full_precision", opts: L2BookOptions{}},
+		{name: "two_sig_figs", opts: L2BookOptions{NSigFigs: 2}},
+		{name: "five_sig_figs_mantissa", opts: L2BookOptions{NSigFigs: 5, Mantissa: 5}},
+		{name: "one_sig_fig", opts: L2BookOptions{NSigFigs: 1}, wantErr: "nSigFigs"},
+		{name: "six_sig_figs", opts: L2BookOptions{NSigFigs: 6}, wantErr: "nSigFigs"},
+		{name: "mantissa_without_sig_figs", opts: L2BookOptions{Mantissa: 2}, wantErr: "mantissa"},
+		{name: "mantissa_with_four_sig_figs", opts: L2BookOptions{NSigFigs: 4, Mantissa: 2}, wantErr: "mantissa"},
+		{name: "invalid_mantissa", opts: L2BookOptions{NSigFigs: 5, Mantissa: 3}, wantErr: "mantissa"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, tt.wantErr, validationErr.Field)
+		})
+	}
+}
+
+func TestSubscription_L2BookOptions(t *testing.T) {
+	sub := Subscription{Type: "l2Book", Coin: "BTC"}
+	sub.NSigFigs, sub.Mantissa = L2BookOptions{NSigFigs: 5, Mantissa: 2}.params()
+
+	data, err := json.Marshal(sub)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"l2Book","coin":"BTC","nSigFigs":5,"mantissa":2}`, string(data))
+
+	key := sub.key()
+	assert.NotEqual(t, Subscription{Type: "l2Book", Coin: "BTC"}.key(), key)
+	assert.Equal(t, sub, key.subscription())
+
+	data, err = json.Marshal(Subscription{Type: "l2Book", Coin: "BTC"}.key().subscription())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"l2Book","coin":"BTC"}`, string(data))
+}
+
+func TestInfo_AggregatedL2Snapshot(t *testing.T) {
+	var requests []map[string]any
+	server := newTestServer(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return L2Book{Coin: "BTC"}
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	_, err := info.AggregatedL2Snapshot("BTC", L2BookOptions{NSigFigs: 5, Mantissa: 2})
+	require.NoError(t, err)
+	_, err = info.L2Snapshot("BTC")
+	require.NoError(t, err)
+	_, err = info.AggregatedL2Snapshot("BTC", L2BookOptions{NSigFigs: 3, Mantissa: 2})
+	require.Error(t, err)
+
+	require.Len(t, requests, 2)
+	assert.Equal(t, float64(5), requests[0]["nSigFigs"])
+	assert.Equal(t, float64(2), requests[0]["mantissa"])
+	assert.NotContains(t, requests[1], "nSigFigs")
+	assert.NotContains(t, requests[1], "mantissa")
+}
+
+func TestWebsocketClient_DispatchL2Book(t *testing.T) {
+	client := NewWebsocketClient(MainnetAPIURL)
+
+	var btc, eth []string
+	subscribe := func(sub Subscription, got *[]string) {
+		client.subscriptions[sub.key()] = map[int]*subscriptionCallback{
+			1: {id: 1, callback: func(msg WSMessage) {
+				*got = append(*got, string(msg.Data))
+			}},
+		}
+	}
+	nSigFigs := 5
+	subscribe(Subscription{Type: "l2Book", Coin: "BTC", NSigFigs: &nSigFigs}, &btc)
+	subscribe(Subscription{Type: "l2Book", Coin: "ETH"}, &eth)
+
+	btcBook := `{"coin":"BTC","time":1700000000000,"levels":[[{"px":"37000","sz":"1.5","n":3}],[{"px":"37010","sz":"0.2","n":1}]]}`
+	ethBook := `{"coin":"ETH","time":1700000000000,"levels":[[],[]]}`
+	for _, data := range []string{btcBook, ethBook, `{"time":1700000000000}`} {
+		client.dispatch(WSMessage{Channel: "l2Book", Data: json.RawMessage(data)})
+	}
+
+	assert.Equal(t, []string{btcBook}, btc)
+	assert.Equal(t, []string{ethBook}, eth)
+
+	_, err := client.SubscribeToOrderbook("BTC", func(WSMessage) {})
+	assert.ErrorContains(t, err, "l2Book of BTC already subscribed with nSigFigs 5 and mantissa 0")
+	_, err = client.SubscribeToAggregatedOrderbook("ETH", L2BookOptions{NSigFigs: 3}, func(WSMessage) {})
+	assert.ErrorContains(t, err, "already subscribed")
+}
@@ -0,0 +1,85 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrPageOverflow is yielded by the paginated history iterators when more
+// rows share a timestamp than a page holds. The cursor can't move past that
+// timestamp, so the rest of the history can't be fetched.
+var ErrPageOverflow = errors.New("more rows share a timestamp than a page holds")
+
+const (
+	// userFillsPageLimit is the maximum number of fills returned by a userFillsByTime request
+	userFillsPageLimit = 2000
+	// userFundingPageLimit is the maximum number of rows returned by a userFunding request
+	userFundingPageLimit = 500
+	// userLedgerPageLimit is the maximum number of rows returned by a userNonFundingLedgerUpdates request
+	userLedgerPageLimit = 500
+)
+
+// paginateByTime walks the rows of a time range query from startTime to
+// endTime. Each page starts at the time of the last row of the previous one,
+// so rows sharing that timestamp aren't skipped; rowKey tells them apart to
+// drop the ones already yielded.
+func paginateByTime[T any](
+	ctx context.Context,
+	startTime, endTime int64,
+	pageLimit int,
+	fetch func(ctx context.Context, startTime, endTime int64) ([]T, error),
+	rowKey func(T) (int64, string),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		boundary := make(map[string]struct{})
+
+		for startTime <= endTime {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(ctx, startTime, endTime)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			lastTime := startTime
+			nextBoundary := make(map[string]struct{})
+			for _, row := range page {
+				rowTime, key := rowKey(row)
+				if _, seen := boundary[key]; seen && rowTime == startTime {
+					continue
+				}
+
+				if rowTime > lastTime {
+					lastTime = rowTime
+					clear(nextBoundary)
+				}
+				if rowTime == lastTime {
+					nextBoundary[key] = struct{}{}
+				}
+
+				if !yield(row, nil) {
+					return
+				}
+			}
+
+			// A short page is the last one
+			if len(page) < pageLimit {
+				return
+			}
+
+			// A full page sharing one timestamp would be served again as is
+			if lastTime == startTime {
+				yield(zero, fmt.Errorf("%w: %d rows at %d", ErrPageOverflow, len(page), startTime))
+				return
+			}
+			startTime, boundary = lastTime, nextBoundary
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package hyperliquid
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type timedRow struct {
+	time int64
+	id   string
+}
+
+// pagedFetch serves rows in pages of at most limit rows starting at startTime,
+// like the time range info endpoints do.
+func pagedFetch(rows []timedRow, limit int, calls *int) func(context.Context, int64, int64) ([]timedRow, error) {
+	return func(_ context.Context, startTime, endTime int64) ([]timedRow, error) {
+		*calls++
+		var page []timedRow
+		for _, row := range rows {
+			if row.time >= startTime && row.time <= endTime && len(page) < limit {
+				page = append(page, row)
+			}
+		}
+		return page, nil
+	}
+}
+
+func timedRowKey(row timedRow) (int64, string) {
+	return row.time, row.id
+}
+
+func TestPaginateByTime(t *testing.T) {
+	rows := []timedRow{
+		{time: 1, id: "a"},
+		{time: 2, id: "b"},
+		{time: 3, id: "c"},
+		{time: 3, id: "d"},
+		{time: 3, id: "e"},
+		{time: 4, id: "f"},
+		{time: 5, id: "g"},
+	}
+
+	t.Run("dedupes_boundary_rows", func(t *testing.T) {
+		calls := 0
+		var got []string
+		for row, err := range paginateByTime(context.Background(), 0, 10, 4, pagedFetch(rows, 4, &calls), timedRowKey) {
+			require.NoError(t, err)
+			got = append(got, row.id)
+		}
+
+		assert.Equal(t, []string{"a", "b", "c", "d", "e", "f", "g"}, got)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("single_short_page", func(t *testing.T) {
+		calls := 0
+		count := 0
+		for _, err := range paginateByTime(context.Background(), 0, 10, 100, pagedFetch(rows, 100, &calls), timedRowKey) {
+			require.NoError(t, err)
+			count++
+		}
+
+		assert.Equal(t, len(rows), count)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("respects_range", func(t *testing.T) {
+		calls := 0
+		var got []string
+		for row, err := range paginateByTime(context.Background(), 2, 3, 4, pagedFetch(rows, 4, &calls), timedRowKey) {
+			require.NoError(t, err)
+			got = append(got, row.id)
+		}
+
+		assert.Equal(t, []string{"b", "c", "d", "e"}, got)
+	})
+
+	t.Run("full_page_single_timestamp", func(t *testing.T) {
+		calls := 0
+		var got []string
+		var lastErr error
+		for row, err := range paginateByTime(context.Background(), 0, 10, 2, pagedFetch(rows, 2, &calls), timedRowKey) {
+			if err != nil {
+				lastErr = err
+				break
+			}
+			got = append(got, row.id)
+		}
+
+		assert.ErrorIs(t, lastErr, ErrPageOverflow)
+		assert.Equal(t, []string{"a", "b", "c", "d"}, got)
+	})
+
+	t.Run("early_break", func(t *testing.T) {
+		calls := 0
+		for range paginateByTime(context.Background(), 0, 10, 2, pagedFetch(rows, 2, &calls), timedRowKey) {
+			break
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("fetch_error", func(t *testing.T) {
+		fetchErr := errors.New("boom")
+		fetch := func(context.Context, int64, int64) ([]timedRow, error) {
+			return nil, fetchErr
+		}
+
+		for _, err := range paginateByTime(context.Background(), 0, 10, 2, fetch, timedRowKey) {
+			assert.ErrorIs(t, err, fetchErr)
+		}
+	})
+
+	t.Run("context_canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		calls := 0
+		var lastErr error
+		for _, err := range paginateByTime(ctx, 0, 10, 2, pagedFetch(rows, 2, &calls), timedRowKey) {
+			if err != nil {
+				lastErr = err
+				break
+			}
+			cancel()
+		}
+
+		assert.ErrorIs(t, lastErr, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -0,0 +1,95 @@
+package hyperliquid
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// IPRateLimitWeight is the request weight Hyperliquid allows per IP and window
+	IPRateLimitWeight = 1200
+	// IPRateLimitWindow is the window over which the request weight is accounted
+	IPRateLimitWindow = time.Minute
+
+	exchangeRequestWeight    = 1
+	lightInfoRequestWeight   = 2
+	defaultInfoRequestWeight = 20
+	userRoleRequestWeight    = 60
+)
+
+// lightInfoRequests are the info request types weighted lightInfoRequestWeight.
+var lightInfoRequests = map[string]struct{}{
+	"l2Book":                 {},
+	"allMids":                {},
+	"clearinghouseState":     {},
+	"orderStatus":            {},
+	"spotClearinghouseState": {},
+	"exchangeStatus":         {},
+}
+
+// rateLimiter is a token bucket over request weights, refilled continuously so
+// that at most capacity weight is spent per window.
+type rateLimiter struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	perSec   float64
+	last     time.Time
+}
+
+func newRateLimiter(weight int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		capacity: float64(weight),
+		tokens:   float64(weight),
+		perSec:   float64(weight) / window.Seconds(),
+		last:     time.Now(),
+	}
+}
+
+// wait blocks until weight can be spent or ctx is done.
+func (l *rateLimiter) wait(ctx context.Context, weight int) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens = min(l.capacity, l.tokens+now.Sub(l.last).Seconds()*l.perSec)
+		l.last = now
+
+		need := min(float64(weight), l.capacity)
+		if l.tokens >= need {
+			l.tokens -= need
+			l.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((need - l.tokens) / l.perSec * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// requestWeight returns the rate limit weight of a request to path.
+func requestWeight(path string, payload any) int {
+	if path != "/info" {
+		return exchangeRequestWeight
+	}
+
+	req, ok := payload.(map[string]any)
+	if !ok {
+		return defaultInfoRequestWeight
+	}
+	typ, _ := req["type"].(string)
+	if _, ok := lightInfoRequests[typ]; ok {
+		return lightInfoRequestWeight
+	}
+	if typ == "userRole" {
+		return userRoleRequestWeight
+	}
+	return defaultInfoRequestWeight
+}
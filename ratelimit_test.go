@@ -0,0 +1,88 @@
+package hyperliquid
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestWeight(t *testing.T) {
+	assert.Equal(t, 1, requestWeight("/exchange", map[string]any{"type": "order"}))
+	assert.Equal(t, 2, requestWeight("/info", map[string]any{"type": "l2Book"}))
+	assert.Equal(t, 20, requestWeight("/info", map[string]any{"type": "userFillsByTime"}))
+	assert.Equal(t, 60, requestWeight("/info", map[string]any{"type": "userRole"}))
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := newRateLimiter(10, time.Second)
+
+	require.NoError(t, limiter.wait(context.Background(), 10))
+
+	start := time.Now()
+	require.NoError(t, limiter.wait(context.Background(), 2))
+	assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.wait(ctx, 10), context.DeadlineExceeded)
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		return map[string]any{}
+	})
+
+	t.Run("unlimited_by_default", func(t *testing.T) {
+		info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+		assert.Nil(t, info.client.limiter.Load())
+
+		start := time.Now()
+		for range 5 {
+			_, err := info.client.post("/info", map[string]any{"type": "userRole"})
+			require.NoError(t, err)
+		}
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("option", func(t *testing.T) {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		exchange := NewExchange(privateKey, server.URL, testMeta(), "", "", testSpotMeta(), WithRateLimit(60, time.Second))
+		// Info and exchange requests are accounted together
+		assert.Same(t, exchange.client, exchange.info.client)
+
+		_, err = exchange.info.client.post("/info", map[string]any{"type": "userRole"})
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = exchange.client.postWithContext(ctx, "/exchange", map[string]any{"type": "order"})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("set_concurrently", func(t *testing.T) {
+		client := NewClient(server.URL)
+
+		var wg sync.WaitGroup
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 20 {
+				_, err := client.post("/info", map[string]any{"type": "allMids"})
+				assert.NoError(t, err)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for n := range 20 {
+				client.SetRateLimit(IPRateLimitWeight*(n%2), IPRateLimitWindow)
+			}
+		}()
+		wg.Wait()
+	})
+}
@@ -0,0 +1,58 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfo_QueryReferralState(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		fixture, err := os.ReadFile(filepath.Join("testdata", "referral_state.json"))
+		require.NoError(t, err)
+		return json.RawMessage(fixture)
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	state, err := info.QueryReferralState("0xabc")
+	require.NoError(t, err)
+
+	require.NotNil(t, state.ReferredBy)
+	assert.Equal(t, "HYPURR", state.ReferredBy.Code)
+	assert.Equal(t, 149428030.6628420055, state.CumVlm)
+	assert.Equal(t, 11.047766, state.UnclaimedRewards)
+	assert.Equal(t, ReferrerStageReady, state.ReferrerState.Stage)
+	assert.Equal(t, "DESK", state.ReferrerState.Data.Code)
+	require.Len(t, state.ReferrerState.Data.ReferralStates, 1)
+	assert.Equal(t, 4.25, state.ReferrerState.Data.ReferralStates[0].CumFeesRewardedToReferrer)
+	assert.Equal(t, []ReferralReward{{Earned: 3.12, Vlm: 520000, ReferralVlm: 31200, Time: 1719878400000}}, state.RewardHistory)
+}
+
+func TestExchange_ReferralActions(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	_, err := exchange.SetReferrer("HYPURR")
+	require.NoError(t, err)
+	_, err = exchange.RegisterReferrer("DESK")
+	require.NoError(t, err)
+	_, err = exchange.ClaimRewards()
+	require.NoError(t, err)
+	_, err = exchange.SetReferrer("")
+	assert.Error(t, err)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, map[string]any{"type": "setReferrer", "code": "HYPURR"}, requests[0]["action"])
+	assert.Equal(t, map[string]any{"type": "registerReferrer", "code": "DESK"}, requests[1]["action"])
+	assert.Equal(t, map[string]any{"type": "claimRewards"}, requests[2]["action"])
+	for _, req := range requests {
+		assert.NotContains(t, req, "vaultAddress")
+	}
+}
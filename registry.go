@@ -0,0 +1,172 @@
+package hyperliquid
+
+import (
+	"sort"
+	"strings"
+)
+
+type AssetChangeType string
+
+const (
+	AssetListed   AssetChangeType = "listed"
+	AssetDelisted AssetChangeType = "delisted"
+)
+
+// AssetChange reports an asset that became tradable or stopped being tradable
+// between two refreshes of the asset metadata.
+type AssetChange struct {
+	Type   AssetChangeType
+	Name   string
+	Asset  int
+	IsSpot bool
+}
+
+// marginTableKey identifies a margin table, whose ids are only unique within a perp dex.
+type marginTableKey struct {
+	dex string
+	id  int
+}
+
+// perpDexMeta is the meta of a builder-deployed perp dex along with its perp dex index.
+type perpDexMeta struct {
+	index int
+	meta  *Meta
+}
+
+// registrySources holds the metadata an assetRegistry is built from.
+type registrySources struct {
+	meta     *Meta
+	spotMeta *SpotMeta
+	dexMetas map[string]perpDexMeta
+}
+
+// assetRegistry is an immutable snapshot of the asset lookup tables. Info
+// swaps whole registries on refresh instead of mutating them in place.
+type assetRegistry struct {
+	coinToAsset    map[string]int
+	nameToCoin     map[string]string
+	assetToDecimal map[int]int
+	spotToAsset    map[string]int
+	perpToAsset    map[string]int
+	assetToPerp    map[int]AssetInfo
+	marginTables   map[marginTableKey]MarginTable
+	spotMarkets    *spotMarkets
+	spotTokens     map[string]SpotTokenInfo
+	spotTokenIDs   map[string]SpotTokenInfo
+}
+
+func buildAssetRegistry(sources registrySources) (*assetRegistry, error) {
+	registry := &assetRegistry{
+		coinToAsset:    make(map[string]int),
+		nameToCoin:     make(map[string]string),
+		assetToDecimal: make(map[int]int),
+		spotToAsset:    make(map[string]int),
+		perpToAsset:    make(map[string]int),
+		assetToPerp:    make(map[int]AssetInfo),
+		marginTables:   make(map[marginTableKey]MarginTable),
+		spotTokens:     make(map[string]SpotTokenInfo),
+		spotTokenIDs:   make(map[string]SpotTokenInfo),
+	}
+
+	spotMarkets, err := buildSpotMarkets(sources.spotMeta)
+	if err != nil {
+		return nil, err
+	}
+	registry.spotMarkets = spotMarkets
+
+	for name, market := range spotMarkets.byName {
+		registry.spotToAsset[name] = market.AssetID
+		registry.nameToCoin[name] = market.Name
+		registry.coinToAsset[market.Name] = market.AssetID
+		registry.assetToDecimal[market.AssetID] = market.Base.SzDecimals
+	}
+
+	for _, token := range sources.spotMeta.Tokens {
+		registry.spotTokenIDs[strings.ToLower(token.Identifier())] = token
+		// Token names aren't unique, prefer the canonical token of a name
+		if existing, ok := registry.spotTokens[token.Name]; ok && (existing.IsCanonical || !token.IsCanonical) {
+			continue
+		}
+		registry.spotTokens[token.Name] = token
+	}
+
+	registry.setPerpMeta(sources.meta, "", 0)
+	for dex, dexMeta := range sources.dexMetas {
+		registry.setPerpMeta(dexMeta.meta, dex, perpDexAssetIndexOffset+dexMeta.index*perpDexAssetIndexStride)
+	}
+
+	return registry, nil
+}
+
+// setPerpMeta indexes the universe of a perp dex, whose asset ids start at offset.
+func (r *assetRegistry) setPerpMeta(meta *Meta, dex string, offset int) {
+	for _, entry := range meta.MarginTables {
+		r.marginTables[marginTableKey{dex: dex, id: entry.ID}] = entry.Table
+	}
+
+	for index, assetInfo := range meta.Universe {
+		asset := offset + index
+		// Builder-deployed dexes already prefix their coins, but don't rely on it
+		if dex != "" && !strings.HasPrefix(assetInfo.Name, dex+perpDexSeparator) {
+			assetInfo.Name = dex + perpDexSeparator + assetInfo.Name
+		}
+
+		// Delisted assets keep their index in the universe but can no longer
+		// be traded, so they are only reachable by asset id.
+		r.assetToPerp[asset] = assetInfo
+		r.assetToDecimal[asset] = assetInfo.SzDecimals
+		if assetInfo.IsDelisted {
+			continue
+		}
+
+		r.perpToAsset[assetInfo.Name] = asset
+		if _, exists := r.coinToAsset[assetInfo.Name]; !exists {
+			r.coinToAsset[assetInfo.Name] = asset
+		}
+		r.nameToCoin[assetInfo.Name] = assetInfo.Name
+	}
+}
+
+// spotAssets returns the tradable spot pairs keyed by asset id.
+func (r *assetRegistry) spotAssets() map[int]string {
+	assets := make(map[int]string)
+	for _, market := range r.spotMarkets.byName {
+		assets[market.AssetID] = market.Name
+	}
+	return assets
+}
+
+// diffAssetRegistries lists the assets listed and delisted from prev to next,
+// ordered by asset id.
+func diffAssetRegistries(prev, next *assetRegistry) []AssetChange {
+	var changes []AssetChange
+
+	for name, asset := range next.perpToAsset {
+		if _, ok := prev.perpToAsset[name]; !ok {
+			changes = append(changes, AssetChange{Type: AssetListed, Name: name, Asset: asset})
+		}
+	}
+	for name, asset := range prev.perpToAsset {
+		if _, ok := next.perpToAsset[name]; !ok {
+			changes = append(changes, AssetChange{Type: AssetDelisted, Name: name, Asset: asset})
+		}
+	}
+
+	prevSpot, nextSpot := prev.spotAssets(), next.spotAssets()
+	for asset, name := range nextSpot {
+		if _, ok := prevSpot[asset]; !ok {
+			changes = append(changes, AssetChange{Type: AssetListed, Name: name, Asset: asset, IsSpot: true})
+		}
+	}
+	for asset, name := range prevSpot {
+		if _, ok := nextSpot[asset]; !ok {
+			changes = append(changes, AssetChange{Type: AssetDelisted, Name: name, Asset: asset, IsSpot: true})
+		}
+	}
+
+	sort.Slice(changes, func(a, b int) bool {
+		return changes[a].Asset < changes[b].Asset
+	})
+
+	return changes
+}
@@ -0,0 +1,88 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Side is the side of the book an order rests on, or the taker side of a
+// fill or trade.
+type Side string
+
+const (
+	SideAsk Side = "A"
+	SideBid Side = "B"
+)
+
+// SideFromIsBuy returns SideBid for buys and SideAsk for sells.
+func SideFromIsBuy(isBuy bool) Side {
+	if isBuy {
+		return SideBid
+	}
+	return SideAsk
+}
+
+// Valid reports whether s is SideAsk or SideBid.
+func (s Side) Valid() bool {
+	return s == SideAsk || s == SideBid
+}
+
+// IsBuy reports whether s is the bid side.
+func (s Side) IsBuy() bool {
+	return s == SideBid
+}
+
+// Opposite returns the other side of the book, or s itself when it is not a
+// valid side.
+func (s Side) Opposite() Side {
+	switch s {
+	case SideBid:
+		return SideAsk
+	case SideAsk:
+		return SideBid
+	default:
+		return s
+	}
+}
+
+// Sign returns 1 for bids and -1 for asks, the sign of a position delta, and 0
+// when s is not a valid side.
+func (s Side) Sign() float64 {
+	switch s {
+	case SideBid:
+		return 1
+	case SideAsk:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Signed returns size signed by the side, positive for bids, negative for asks
+// and 0 when s is not a valid side.
+func (s Side) Signed(size float64) float64 {
+	return s.Sign() * size
+}
+
+// MarshalJSON encodes the side, rejecting values other than SideAsk, SideBid
+// and the zero value.
+func (s Side) MarshalJSON() ([]byte, error) {
+	if s != "" && !s.Valid() {
+		return nil, fmt.Errorf("invalid side %q", string(s))
+	}
+	return json.Marshal(string(s))
+}
+
+// UnmarshalJSON decodes the side, rejecting values other than "A", "B" and "".
+func (s *Side) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("side: %w", err)
+	}
+	side := Side(raw)
+	if side != "" && !side.Valid() {
+		return fmt.Errorf("invalid side %q", raw)
+	}
+	*s = side
+	return nil
+}
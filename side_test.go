@@ -0,0 +1,49 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSide_Helpers(t *testing.T) {
+	assert.Equal(t, SideBid, SideFromIsBuy(true))
+	assert.Equal(t, SideAsk, SideFromIsBuy(false))
+
+	assert.True(t, SideBid.IsBuy())
+	assert.False(t, SideAsk.IsBuy())
+	assert.Equal(t, SideAsk, SideBid.Opposite())
+	assert.Equal(t, SideBid, SideAsk.Opposite())
+	assert.Equal(t, 2.5, SideBid.Signed(2.5))
+	assert.Equal(t, -2.5, SideAsk.Signed(2.5))
+	assert.False(t, Side("X").Valid())
+
+	// Unset and invalid sides never default to either side
+	for _, side := range []Side{"", "X"} {
+		assert.Equal(t, side, side.Opposite())
+		assert.Zero(t, side.Sign())
+		assert.Zero(t, side.Signed(2.5))
+	}
+}
+
+func TestSide_JSON(t *testing.T) {
+	var fill Fill
+	require.NoError(t, json.Unmarshal([]byte(`{"coin":"BTC","side":"A","px":"50000","sz":"0.1"}`), &fill))
+	assert.Equal(t, SideAsk, fill.Side)
+
+	var trade Trade
+	require.NoError(t, json.Unmarshal([]byte(`{"coin":"ETH","side":"B"}`), &trade))
+	assert.True(t, trade.Side.IsBuy())
+
+	var order OpenOrder
+	assert.Error(t, json.Unmarshal([]byte(`{"coin":"BTC","side":"buy"}`), &order))
+
+	data, err := json.Marshal(FrontendOrder{Coin: "BTC", Side: SideBid})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"side":"B"`)
+
+	_, err = json.Marshal(OpenOrder{Side: "X"})
+	assert.Error(t, err)
+}
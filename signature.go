@@ -0,0 +1,110 @@
+package hyperliquid
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signature is an ECDSA signature in the {r, s, v} form /exchange payloads
+// carry, V being 27 or 28.
+type Signature struct {
+	R common.Hash `json:"r"`
+	S common.Hash `json:"s"`
+	V byte        `json:"v"`
+}
+
+// NewSignature returns the Signature of a 65 bytes [R || S || V] signature,
+// V being 0, 1, 27 or 28.
+func NewSignature(sig []byte) (Signature, error) {
+	if len(sig) != crypto.SignatureLength {
+		return Signature{}, fmt.Errorf("invalid signature length %d", len(sig))
+	}
+
+	v := sig[64]
+	if v < 27 {
+		v += 27
+	}
+	if v != 27 && v != 28 {
+		return Signature{}, fmt.Errorf("invalid signature v %d", sig[64])
+	}
+
+	return Signature{
+		R: common.BytesToHash(sig[:32]),
+		S: common.BytesToHash(sig[32:64]),
+		V: v,
+	}, nil
+}
+
+// ParseSignature parses a hex encoded 65 bytes [R || S || V] signature.
+func ParseSignature(s string) (Signature, error) {
+	sig, err := hexutil.Decode(s)
+	if err != nil {
+		return Signature{}, fmt.Errorf("invalid signature: %w", err)
+	}
+	return NewSignature(sig)
+}
+
+// Bytes returns the 65 bytes [R || S || V] form of the signature.
+func (s Signature) Bytes() []byte {
+	sig := make([]byte, 0, crypto.SignatureLength)
+	sig = append(sig, s.R.Bytes()...)
+	sig = append(sig, s.S.Bytes()...)
+	return append(sig, s.V)
+}
+
+// Hex returns the hex encoded 65 bytes [R || S || V] form of the signature.
+func (s Signature) Hex() string {
+	return hexutil.Encode(s.Bytes())
+}
+
+// UnmarshalJSON decodes r and s of up to 32 bytes, including the minimal hex
+// the Python SDK emits when their leading bytes are zero.
+func (s *Signature) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		R string `json:"r"`
+		S string `json:"s"`
+		V byte   `json:"v"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r, err := decodeSignatureValue(raw.R)
+	if err != nil {
+		return fmt.Errorf("invalid signature r: %w", err)
+	}
+	sv, err := decodeSignatureValue(raw.S)
+	if err != nil {
+		return fmt.Errorf("invalid signature s: %w", err)
+	}
+
+	*s = Signature{R: r, S: sv, V: raw.V}
+	return nil
+}
+
+// decodeSignatureValue decodes a 0x prefixed hex value of up to 32 bytes,
+// left padding it to a hash.
+func decodeSignatureValue(value string) (common.Hash, error) {
+	digits, ok := strings.CutPrefix(value, "0x")
+	if !ok || digits == "" {
+		return common.Hash{}, fmt.Errorf("expected 0x prefixed hex, got %q", value)
+	}
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+
+	b, err := hex.DecodeString(digits)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("value exceeds %d bytes", common.HashLength)
+	}
+	return common.BytesToHash(b), nil
+}
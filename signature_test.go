@@ -0,0 +1,126 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSignatureHex = "0x" +
+	"5ec1b3f5e5a1c0e2e6d1b3c95e1f58b9b4a0d1e4f3c8b27d7e6a1b0c9d8e7f60" +
+	"0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9" +
+	"1c"
+
+func TestSignature_Hex(t *testing.T) {
+	sig, err := ParseSignature(testSignatureHex)
+	require.NoError(t, err)
+
+	assert.Equal(t, Signature{
+		R: common.HexToHash("0x5ec1b3f5e5a1c0e2e6d1b3c95e1f58b9b4a0d1e4f3c8b27d7e6a1b0c9d8e7f60"),
+		S: common.HexToHash("0x0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"),
+		V: 28,
+	}, sig)
+	assert.Equal(t, testSignatureHex, sig.Hex())
+	assert.Len(t, sig.Bytes(), 65)
+
+	// Recovery ids are normalized to 27 and 28
+	raw := sig.Bytes()
+	raw[64] = 0
+	sig, err = NewSignature(raw)
+	require.NoError(t, err)
+	assert.Equal(t, byte(27), sig.V)
+}
+
+func TestSignature_JSON(t *testing.T) {
+	sig, err := ParseSignature(testSignatureHex)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(sig)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"r": "0x5ec1b3f5e5a1c0e2e6d1b3c95e1f58b9b4a0d1e4f3c8b27d7e6a1b0c9d8e7f60",
+		"s": "0x0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+		"v": 28
+	}`, string(data))
+
+	var decoded Signature
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, sig, decoded)
+}
+
+func TestSignature_UnmarshalSDKJSON(t *testing.T) {
+	// Mainnet signature of {"type":"dummy","num":100000000000} by testSDKKey, as
+	// returned by the Python SDK's sign_l1_action with r in minimal hex
+	var sig Signature
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"r": "0x53749d5b30552aeb2fca34b530185976545bb22d0b3ce6f62e31be961a59298",
+		"s": "0x755c40ba9bf05223521753995abb2f73ab3229be8ec921f350cb447e384d8ed8",
+		"v": 27
+	}`), &sig))
+
+	assert.Equal(t, Signature{
+		R: common.HexToHash("0x053749d5b30552aeb2fca34b530185976545bb22d0b3ce6f62e31be961a59298"),
+		S: common.HexToHash("0x755c40ba9bf05223521753995abb2f73ab3229be8ec921f350cb447e384d8ed8"),
+		V: 27,
+	}, sig)
+
+	recovered, err := RecoverL1ActionSigner(json.RawMessage(`{"type":"dummy","num":100000000000}`), "", 0, nil, true, sig)
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToAddress("0x14791697260E4c9A71f18484C9f997B308e59325"), recovered)
+}
+
+func TestSignature_UnmarshalJSONInvalid(t *testing.T) {
+	s := "0x755c40ba9bf05223521753995abb2f73ab3229be8ec921f350cb447e384d8ed8"
+	tests := map[string]string{
+		"missing_r": `{"s":"` + s + `","v":27}`,
+		"no_prefix": `{"r":"` + s[2:] + `","s":"` + s + `","v":27}`,
+		"not_hex":   `{"r":"0xzz","s":"` + s + `","v":27}`,
+		"too_long":  `{"r":"` + s + `00","s":"` + s + `","v":27}`,
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			var sig Signature
+			assert.Error(t, json.Unmarshal([]byte(data), &sig))
+		})
+	}
+}
+
+func TestParseSignature_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"empty":     "",
+		"not_hex":   "0xzz",
+		"too_short": "0x1234",
+		"invalid_v": testSignatureHex[:len(testSignatureHex)-2] + "05",
+		"too_long":  testSignatureHex + strings.Repeat("00", 1),
+	}
+
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSignature(s)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExchange_PostActionSignature(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	_, err := exchange.UpdateLeverage("BTC", 5, false)
+	require.NoError(t, err)
+
+	require.Len(t, requests, 1)
+	sig, ok := requests[0]["signature"].(map[string]any)
+	require.True(t, ok)
+	assert.Len(t, sig["r"], 66)
+	assert.Len(t, sig["s"], 66)
+	assert.Contains(t, []any{float64(27), float64(28)}, sig["v"])
+}
@@ -0,0 +1,40 @@
+package hyperliquid
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs action digests on behalf of an address. SignHash returns a 65
+// bytes [R || S || V] signature, with V being 27 or 28.
+type Signer interface {
+	Address() common.Address
+	SignHash(hash []byte) ([]byte, error)
+}
+
+// PrivateKeySigner is a Signer holding its private key in memory.
+type PrivateKeySigner struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func NewPrivateKeySigner(privateKey *ecdsa.PrivateKey) *PrivateKeySigner {
+	return &PrivateKeySigner{privateKey: privateKey}
+}
+
+func (s *PrivateKeySigner) Address() common.Address {
+	return crypto.PubkeyToAddress(s.privateKey.PublicKey)
+}
+
+func (s *PrivateKeySigner) SignHash(hash []byte) ([]byte, error) {
+	signature, err := crypto.Sign(hash, s.privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert to Ethereum signature format
+	signature[64] += 27
+
+	return signature, nil
+}
@@ -2,11 +2,16 @@ package hyperliquid
 
 import (
 	"crypto/ecdsa"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 func SignL1Action(
@@ -15,44 +20,178 @@ func SignL1Action(
 	vaultAddress string,
 	timestamp int64,
 	isMainnet bool,
-) (string, error) {
-	chainID := "0x1"
-	if !isMainnet {
-		chainID = "0x66eee"
+) (Signature, error) {
+	return SignL1ActionWithExpiry(privateKey, action, vaultAddress, timestamp, nil, isMainnet)
+}
+
+// SignL1ActionWithExpiry signs an L1 action that Hyperliquid rejects once
+// expiresAfter, in milliseconds, is past. A nil expiresAfter never expires.
+func SignL1ActionWithExpiry(
+	privateKey *ecdsa.PrivateKey,
+	action any,
+	vaultAddress string,
+	timestamp int64,
+	expiresAfter *int64,
+	isMainnet bool,
+) (Signature, error) {
+	hash, err := l1ActionHash(action, vaultAddress, timestamp, expiresAfter, isMainnet)
+	if err != nil {
+		return Signature{}, err
+	}
+
+	return signHash(NewPrivateKeySigner(privateKey), hash)
+}
+
+const (
+	// agentDomainName is the EIP-712 domain name of the phantom agents L1 actions are signed as
+	agentDomainName = "Exchange"
+	// agentDomainVersion is the EIP-712 domain version of phantom agents
+	agentDomainVersion = "1"
+	// agentChainID is the chain id phantom agents are signed for, on mainnet and testnet alike
+	agentChainID = 1337
+
+	mainnetAgentSource = "a"
+	testnetAgentSource = "b"
+)
+
+var agentFields = []EIP712Field{
+	{Name: "source", Type: "string"},
+	{Name: "connectionId", Type: "bytes32"},
+}
+
+// ActionField is a field of an ActionFields.
+type ActionField struct {
+	Key   string
+	Value any
+}
+
+// ActionFields is an action encoded with its fields in order, as the hash of
+// L1 actions depends on the order Hyperliquid defines their fields in. Values
+// are encoded as JSON, maps with their keys sorted.
+type ActionFields []ActionField
+
+// MarshalJSON encodes the fields as a JSON object, in order.
+func (a ActionFields) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, field := range a {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		key, err := json.Marshal(field.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(field.Value)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Key, err)
+		}
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+		buf = append(buf, value...)
 	}
+	return append(buf, '}'), nil
+}
 
-	msg := map[string]any{
-		"action":       action,
-		"chainId":      chainID,
-		"nonce":        timestamp,
-		"vaultAddress": vaultAddress,
+// toMap returns the fields as a map, for user-signed actions whose EIP-712
+// type sets the order.
+func (a ActionFields) toMap() map[string]any {
+	m := make(map[string]any, len(a))
+	for _, field := range a {
+		m[field.Key] = field.Value
 	}
+	return m
+}
 
-	msgJSON, err := json.Marshal(msg)
+// get returns the value of the field key, nil if there is none.
+func (a ActionFields) get(key string) any {
+	for _, field := range a {
+		if field.Key == key {
+			return field.Value
+		}
+	}
+	return nil
+}
+
+// actionHash returns the hash identifying an L1 action, that is
+// keccak256(msgpack(action) || nonce || vault || expiry) where the nonce is 8
+// bytes big-endian, the vault 0x00 or 0x01 followed by its address, and the
+// expiry is either empty or 0x00 followed by expiresAfter as 8 bytes
+// big-endian.
+func actionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64) ([]byte, error) {
+	data, err := packAction(action)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal message: %w", err)
+		return nil, err
+	}
+
+	data = binary.BigEndian.AppendUint64(data, uint64(nonce))
+	if vaultAddress == "" {
+		data = append(data, 0x00)
+	} else {
+		if !common.IsHexAddress(vaultAddress) {
+			return nil, fmt.Errorf("invalid vault address %s", vaultAddress)
+		}
+		data = append(data, 0x01)
+		data = append(data, common.HexToAddress(vaultAddress).Bytes()...)
+	}
+	if expiresAfter != nil {
+		data = append(data, 0x00)
+		data = binary.BigEndian.AppendUint64(data, uint64(*expiresAfter))
 	}
 
-	fmt.Println("payload", string(msgJSON))
+	return crypto.Keccak256(data), nil
+}
 
-	hash := crypto.Keccak256Hash(msgJSON)
-	signature, err := crypto.Sign(hash.Bytes(), privateKey)
+// l1ActionHash returns the digest signed for an L1 action, the EIP-712 hash
+// of the phantom agent whose connection id is the hash of the action.
+func l1ActionHash(action any, vaultAddress string, nonce int64, expiresAfter *int64, isMainnet bool) ([]byte, error) {
+	connectionID, err := actionHash(action, vaultAddress, nonce, expiresAfter)
 	if err != nil {
-		return "", fmt.Errorf("failed to sign message: %w", err)
+		return nil, err
 	}
 
-	// Convert to Ethereum signature format
-	signature[64] += 27
+	source := testnetAgentSource
+	if isMainnet {
+		source = mainnetAgentSource
+	}
 
-	return hexutil.Encode(signature), nil
+	hash, _, err := apitypes.TypedDataAndHash(apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": eip712DomainFields,
+			"Agent":        agentFields,
+		},
+		PrimaryType: "Agent",
+		Domain: apitypes.TypedDataDomain{
+			Name:              agentDomainName,
+			Version:           agentDomainVersion,
+			ChainId:           math.NewHexOrDecimal256(agentChainID),
+			VerifyingContract: common.Address{}.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"source":       source,
+			"connectionId": connectionID,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash agent: %w", err)
+	}
+	return hash, nil
+}
+
+// signHash signs hash with signer.
+func signHash(signer Signer, hash []byte) (Signature, error) {
+	signature, err := signer.SignHash(hash)
+	if err != nil {
+		return Signature{}, fmt.Errorf("failed to sign message: %w", err)
+	}
+	return NewSignature(signature)
 }
 
 func OrderRequestToWire(req OrderRequest, asset int) OrderWire {
 	wire := OrderWire{
 		Asset:      asset,
-		IsBuy:      req.IsBuy,
-		Size:       fmt.Sprintf("%.8f", req.Size),
-		LimitPx:    fmt.Sprintf("%.8f", req.LimitPx),
+		IsBuy:      req.Side.IsBuy(),
+		LimitPx:    floatToWire(req.LimitPx),
+		Size:       floatToWire(req.Size),
 		ReduceOnly: req.ReduceOnly,
 	}
 
@@ -75,4 +214,15 @@ func OrderRequestToWire(req OrderRequest, asset int) OrderWire {
 	return wire
 }
 
+// floatToWire formats a price or size with at most 8 decimals and no trailing
+// zeros, such as "100" or "0.0123", as Hyperliquid hashes it.
+func floatToWire(x float64) string {
+	s := strconv.FormatFloat(x, 'f', 8, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
 // ... Add other signing helper functions
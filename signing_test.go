@@ -0,0 +1,214 @@
+package hyperliquid
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testSDKKey is the private key of the Hyperliquid Python SDK signing tests.
+const testSDKKey = "0123456789012345678901234567890123456789012345678901234567890123"
+
+// testDummyAction is {"type": "dummy", "num": float_to_int_for_hashing(1000)}
+// of the Python SDK signing tests.
+var testDummyAction = ActionFields{{"type", "dummy"}, {"num", int64(100000000000)}}
+
+func TestSignL1Action(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(testSDKKey)
+	require.NoError(t, err)
+
+	order := OrderRequest{
+		Coin:      "ETH",
+		Side:      SideBid,
+		Size:      100,
+		LimitPx:   100,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: "Gtc"}},
+	}
+	orderAction := ActionFields{
+		{"type", "order"},
+		{"orders", []OrderWire{OrderRequestToWire(order, 1)}},
+		{"grouping", "na"},
+	}
+
+	// Signatures of the Python SDK signing tests
+	tests := []struct {
+		name         string
+		action       any
+		vaultAddress string
+		isMainnet    bool
+		r, s         string
+		v            byte
+	}{
+		{
+			name:      "mainnet",
+			action:    testDummyAction,
+			isMainnet: true,
+			r:         "0x053749d5b30552aeb2fca34b530185976545bb22d0b3ce6f62e31be961a59298",
+			s:         "0x755c40ba9bf05223521753995abb2f73ab3229be8ec921f350cb447e384d8ed8",
+			v:         27,
+		},
+		{
+			name:   "testnet",
+			action: testDummyAction,
+			r:      "0x542af61ef1f429707e3c76c5293c80d01f74ef853e34b76efffcb57e574f9510",
+			s:      "0x17b8b32f086e8cdede991f1e2c529f5dd5297cbe8128500e00cbaf766204a613",
+			v:      28,
+		},
+		{
+			name:         "vault",
+			action:       testDummyAction,
+			vaultAddress: "0x1719884eb866cb12b2287399b15f7db5e7d775ea",
+			isMainnet:    true,
+			r:            "0x003c548db75e479f8012acf3000ca3a6b05606bc2ec0c29c50c515066a326239",
+			s:            "0x4d402be7396ce74fbba3795769cda45aec00dc3125a984f2a9f23177b190da2c",
+			v:            28,
+		},
+		{
+			name:      "order_mainnet",
+			action:    orderAction,
+			isMainnet: true,
+			r:         "0xd65369825a9df5d80099e513cce430311d7d26ddf477f5b3a33d2806b100d78e",
+			s:         "0x2b54116ff64054968aa237c20ca9ff68000f977c93289157748a3162b6ea940e",
+			v:         28,
+		},
+		{
+			name:   "order_testnet",
+			action: orderAction,
+			r:      "0x82b2ba28e76b3d761093aaded1b1cdad4960b3af30212b343fb2e6cdfa4e3d54",
+			s:      "0x6b53878fc99d26047f4d7e8c90eb98955a109f44209163f52d8dc4278cbbd9f5",
+			v:      27,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sig, err := SignL1Action(privateKey, tt.action, tt.vaultAddress, 0, tt.isMainnet)
+			require.NoError(t, err)
+			assert.Equal(t, common.HexToHash(tt.r), sig.R)
+			assert.Equal(t, common.HexToHash(tt.s), sig.S)
+			assert.Equal(t, tt.v, sig.V)
+		})
+	}
+}
+
+// TestExchange_BulkOrdersSDKSignature places the order of the Python SDK
+// signing tests and checks the action and signature BulkOrders posts.
+func TestExchange_BulkOrdersSDKSignature(t *testing.T) {
+	privateKey, err := crypto.HexToECDSA(testSDKKey)
+	require.NoError(t, err)
+
+	var posted struct {
+		Action    json.RawMessage `json:"action"`
+		Nonce     int64           `json:"nonce"`
+		Signature Signature       `json:"signature"`
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&posted); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok","response":{"type":"order","data":{"statuses":[{"resting":{"oid":1}}]}}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	// ETH is asset 1 in the SDK tests
+	meta := &Meta{Universe: []AssetInfo{{Name: "BTC", SzDecimals: 5}, {Name: "ETH", SzDecimals: 4}}}
+	exchange := NewExchange(privateKey, server.URL, meta, "", "", testSpotMeta())
+	exchange.nonces = &nonceManager{last: -1, now: func() int64 { return 0 }}
+
+	_, err = exchange.BulkOrders([]OrderRequest{{
+		Coin:      "ETH",
+		Side:      SideBid,
+		Size:      100,
+		LimitPx:   100,
+		OrderType: OrderType{Limit: &LimitOrderType{Tif: "Gtc"}},
+	}}, nil, false)
+	require.NoError(t, err)
+
+	sdkAction := `{"type":"order","orders":[{"a":1,"b":true,"p":"100","s":"100","r":false,"t":{"limit":{"tif":"Gtc"}}}],"grouping":"na"}`
+	assert.JSONEq(t, sdkAction, string(posted.Action))
+	postedHash, err := actionHash(posted.Action, "", posted.Nonce, nil)
+	require.NoError(t, err)
+	sdkHash, err := actionHash(json.RawMessage(sdkAction), "", 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, sdkHash, postedHash)
+
+	// Signature of the order_testnet case of TestSignL1Action
+	assert.Equal(t, int64(0), posted.Nonce)
+	assert.Equal(t, common.HexToHash("0x82b2ba28e76b3d761093aaded1b1cdad4960b3af30212b343fb2e6cdfa4e3d54"), posted.Signature.R)
+	assert.Equal(t, common.HexToHash("0x6b53878fc99d26047f4d7e8c90eb98955a109f44209163f52d8dc4278cbbd9f5"), posted.Signature.S)
+	assert.Equal(t, byte(27), posted.Signature.V)
+}
+
+func TestActionHash(t *testing.T) {
+	// msgpack.packb of the dummy action
+	packed := "82a474797065a564756d6d79a36e756dcf000000174876e800"
+	expiresAfter := int64(1700000030000)
+
+	tests := []struct {
+		name         string
+		vaultAddress string
+		expiresAfter *int64
+		suffix       string
+	}{
+		{name: "no_vault", suffix: "00"},
+		{
+			name:         "vault",
+			vaultAddress: "0x1719884eb866cb12b2287399b15f7db5e7d775ea",
+			suffix:       "011719884eb866cb12b2287399b15f7db5e7d775ea",
+		},
+		{name: "expires_after", expiresAfter: &expiresAfter, suffix: "00" + "00" + "0000018bcfe5dd30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preimage, err := hex.DecodeString(packed + "0000018bcfe56800" + tt.suffix)
+			require.NoError(t, err)
+
+			hash, err := actionHash(testDummyAction, tt.vaultAddress, 1700000000000, tt.expiresAfter)
+			require.NoError(t, err)
+			assert.Equal(t, crypto.Keccak256(preimage), hash)
+		})
+	}
+
+	_, err := actionHash(testDummyAction, "0x1234", 0, nil)
+	assert.Error(t, err)
+}
+
+func TestPackAction(t *testing.T) {
+	tests := []struct {
+		name   string
+		action any
+		want   string
+	}{
+		{name: "ordered_fields", action: ActionFields{{"b", 1}, {"a", nil}}, want: "82a16201a161c0"},
+		{name: "sorted_map", action: map[string]any{"b": true, "a": false}, want: "82a161c2a162c3"},
+		{name: "negative", action: []int64{-1, -33, -129, -32769, -2147483649}, want: "95ffd0dfd1ff7fd2ffff7fffd3ffffffff7fffffff"},
+		{name: "uint", action: []uint64{127, 128, 256, 65536, 1 << 32}, want: "957fcc80cd0100ce00010000cf0000000100000000"},
+		{name: "float", action: 0.5, want: "cb3fe0000000000000"},
+		{name: "str8", action: "0x1719884eb866cb12b2287399b15f7db5e7d775ea", want: "d92a" + hex.EncodeToString([]byte("0x1719884eb866cb12b2287399b15f7db5e7d775ea"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packed, err := packAction(tt.action)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, hex.EncodeToString(packed))
+		})
+	}
+}
+
+func TestFloatToWire(t *testing.T) {
+	assert.Equal(t, "100", floatToWire(100))
+	assert.Equal(t, "0.0123", floatToWire(0.0123))
+	assert.Equal(t, "1670.1", floatToWire(1670.1))
+	assert.Equal(t, "0", floatToWire(-0.000000001))
+}
@@ -0,0 +1,157 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// spotIndexPrefix prefixes the "@index" identifiers of non-canonical spot pairs
+	spotIndexPrefix = "@"
+	// usdcTokenIndex is the index of USDC in the spot token list
+	usdcTokenIndex = 0
+)
+
+// SpotMarket describes a spot trading pair together with both of its tokens.
+type SpotMarket struct {
+	// Name is the pair name used by the API, e.g. "PURR/USDC" or "@107".
+	Name    string
+	Index   int
+	AssetID int
+	Base    SpotTokenInfo
+	Quote   SpotTokenInfo
+}
+
+// PairName returns the human readable "BASE/QUOTE" name of the market.
+func (m SpotMarket) PairName() string {
+	return m.Base.Name + "/" + m.Quote.Name
+}
+
+type spotPair struct {
+	base  string
+	quote string
+}
+
+// spotMarkets indexes spot pairs by every identifier they can be addressed with.
+type spotMarkets struct {
+	byName   map[string]SpotMarket
+	byTokens map[spotPair]SpotMarket
+}
+
+// buildSpotMarkets resolves every pair of spotMeta into a SpotMarket and indexes
+// it by its universe name, its "@index" alias, its "BASE/QUOTE" name and, when
+// unambiguous, its bare base token name. Derived names claimed by more than one
+// pair are dropped instead of silently resolving to either of them.
+func buildSpotMarkets(spotMeta *SpotMeta) (*spotMarkets, error) {
+	markets := &spotMarkets{
+		byName:   make(map[string]SpotMarket),
+		byTokens: make(map[spotPair]SpotMarket),
+	}
+
+	resolved := make([]SpotMarket, 0, len(spotMeta.Universe))
+	for _, spotInfo := range spotMeta.Universe {
+		if len(spotInfo.Tokens) != 2 {
+			return nil, fmt.Errorf("spot pair %s: expected 2 tokens, got %d", spotInfo.Name, len(spotInfo.Tokens))
+		}
+
+		base, err := spotToken(spotMeta, spotInfo.Tokens[0])
+		if err != nil {
+			return nil, fmt.Errorf("spot pair %s: %w", spotInfo.Name, err)
+		}
+		quote, err := spotToken(spotMeta, spotInfo.Tokens[1])
+		if err != nil {
+			return nil, fmt.Errorf("spot pair %s: %w", spotInfo.Name, err)
+		}
+
+		market := SpotMarket{
+			Name:    spotInfo.Name,
+			Index:   spotInfo.Index,
+			AssetID: spotInfo.Index + spotAssetIndexOffset,
+			Base:    base,
+			Quote:   quote,
+		}
+		resolved = append(resolved, market)
+
+		markets.byName[market.Name] = market
+		markets.byName[spotIndexPrefix+strconv.Itoa(market.Index)] = market
+	}
+
+	derived := make(map[string]SpotMarket)
+	conflicts := make(map[string]struct{})
+	claim := func(name string, market SpotMarket) {
+		if _, ok := markets.byName[name]; ok {
+			return
+		}
+		if existing, ok := derived[name]; ok && existing.Index != market.Index {
+			conflicts[name] = struct{}{}
+			return
+		}
+		derived[name] = market
+	}
+
+	pairConflicts := make(map[spotPair]struct{})
+	basePairs := make(map[string][]SpotMarket)
+	for _, market := range resolved {
+		pair := spotPair{base: market.Base.Name, quote: market.Quote.Name}
+		if existing, ok := markets.byTokens[pair]; ok && existing.Index != market.Index {
+			pairConflicts[pair] = struct{}{}
+		}
+		markets.byTokens[pair] = market
+
+		claim(market.PairName(), market)
+		basePairs[market.Base.Name] = append(basePairs[market.Base.Name], market)
+	}
+
+	// A bare base token name resolves to its USDC pair, or to its only pair
+	// when it isn't quoted in USDC at all.
+	for base, pairs := range basePairs {
+		if market, ok := preferredSpotMarket(pairs); ok {
+			claim(base, market)
+		}
+	}
+
+	for pair := range pairConflicts {
+		delete(markets.byTokens, pair)
+	}
+	for name := range conflicts {
+		delete(derived, name)
+	}
+	for name, market := range derived {
+		markets.byName[name] = market
+	}
+
+	return markets, nil
+}
+
+func preferredSpotMarket(pairs []SpotMarket) (SpotMarket, bool) {
+	if len(pairs) == 1 {
+		return pairs[0], true
+	}
+
+	var (
+		found  SpotMarket
+		nFound int
+	)
+	for _, market := range pairs {
+		if market.Quote.Index == usdcTokenIndex {
+			found = market
+			nFound++
+		}
+	}
+	return found, nFound == 1
+}
+
+func spotToken(spotMeta *SpotMeta, index int) (SpotTokenInfo, error) {
+	if index < 0 || index >= len(spotMeta.Tokens) {
+		return SpotTokenInfo{}, fmt.Errorf("unknown token index %d", index)
+	}
+	return spotMeta.Tokens[index], nil
+}
+
+// spotTokenSeparator separates the name and the id of spot token identifiers
+const spotTokenSeparator = ":"
+
+// Identifier returns the "NAME:tokenId" form of the token used by transfers.
+func (t SpotTokenInfo) Identifier() string {
+	return t.Name + spotTokenSeparator + t.TokenID
+}
@@ -0,0 +1,100 @@
+package hyperliquid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSpotMarkets(t *testing.T) {
+	spotMeta := &SpotMeta{
+		Universe: []SpotAssetInfo{
+			{Name: "PURR/USDC", Tokens: []int{1, 0}, Index: 0, IsCanonical: true},
+			{Name: "@107", Tokens: []int{2, 0}, Index: 107},
+			{Name: "@142", Tokens: []int{2, 3}, Index: 142},
+			{Name: "@150", Tokens: []int{4, 3}, Index: 150},
+			{Name: "@151", Tokens: []int{4, 1}, Index: 151},
+			{Name: "@200", Tokens: []int{5, 0}, Index: 200},
+			{Name: "@201", Tokens: []int{5, 0}, Index: 201},
+		},
+		Tokens: []SpotTokenInfo{
+			{Name: "USDC", SzDecimals: 8, WeiDecimals: 8, Index: 0},
+			{Name: "PURR", SzDecimals: 0, WeiDecimals: 5, Index: 1},
+			{Name: "HYPE", SzDecimals: 2, WeiDecimals: 8, Index: 2},
+			{Name: "USDT0", SzDecimals: 2, WeiDecimals: 8, Index: 3},
+			{Name: "UBTC", SzDecimals: 5, WeiDecimals: 10, Index: 4},
+			{Name: "DUP", SzDecimals: 1, WeiDecimals: 6, Index: 5},
+		},
+	}
+
+	markets, err := buildSpotMarkets(spotMeta)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		lookup    string
+		wantIndex int
+		wantFound bool
+	}{
+		{name: "canonical_pair_name", lookup: "PURR/USDC", wantIndex: 0, wantFound: true},
+		{name: "index_alias_of_canonical_pair", lookup: "@0", wantIndex: 0, wantFound: true},
+		{name: "index_name", lookup: "@107", wantIndex: 107, wantFound: true},
+		{name: "derived_pair_name", lookup: "HYPE/USDC", wantIndex: 107, wantFound: true},
+		{name: "non_usdc_quote", lookup: "HYPE/USDT0", wantIndex: 142, wantFound: true},
+		{name: "bare_base_prefers_usdc", lookup: "HYPE", wantIndex: 107, wantFound: true},
+		{name: "bare_base_without_usdc_pair_is_ambiguous", lookup: "UBTC", wantFound: false},
+		{name: "conflicting_pair_name_dropped", lookup: "DUP/USDC", wantFound: false},
+		{name: "conflicting_pair_still_reachable_by_index", lookup: "@201", wantIndex: 201, wantFound: true},
+		{name: "unknown", lookup: "FOO/USDC", wantFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			market, ok := markets.byName[tt.lookup]
+			require.Equal(t, tt.wantFound, ok)
+			if tt.wantFound {
+				assert.Equal(t, tt.wantIndex, market.Index)
+				assert.Equal(t, tt.wantIndex+spotAssetIndexOffset, market.AssetID)
+			}
+		})
+	}
+
+	t.Run("by_tokens", func(t *testing.T) {
+		market, ok := markets.byTokens[spotPair{base: "UBTC", quote: "PURR"}]
+		require.True(t, ok)
+		assert.Equal(t, "@151", market.Name)
+		assert.Equal(t, 5, market.Base.SzDecimals)
+		assert.Equal(t, 0, market.Quote.SzDecimals)
+
+		_, ok = markets.byTokens[spotPair{base: "DUP", quote: "USDC"}]
+		assert.False(t, ok)
+	})
+
+	t.Run("unknown_token_index", func(t *testing.T) {
+		_, err := buildSpotMarkets(&SpotMeta{
+			Universe: []SpotAssetInfo{{Name: "@1", Tokens: []int{9, 0}, Index: 1}},
+			Tokens:   spotMeta.Tokens,
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestInfo_SpotMarket(t *testing.T) {
+	info := NewInfo(LocalAPIURL, true, testMeta(), testSpotMeta())
+
+	market, ok := info.SpotMarket("PURR")
+	require.True(t, ok)
+	assert.Equal(t, "PURR/USDC", market.Name)
+	assert.Equal(t, "PURR/USDC", market.PairName())
+
+	asset, ok := info.SpotAsset("@0")
+	require.True(t, ok)
+	assert.Equal(t, spotAssetIndexOffset, asset)
+
+	market, ok = info.SpotMarketByTokens("PURR", "USDC")
+	require.True(t, ok)
+	assert.Equal(t, 8, market.Quote.SzDecimals)
+
+	assert.Equal(t, spotAssetIndexOffset, info.NameToAsset("PURR/USDC"))
+}
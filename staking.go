@@ -0,0 +1,85 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ValidatorSummary describes a validator of the Hyperliquid L1. Stake is in
+// wei of HYPE.
+type ValidatorSummary struct {
+	Validator       string           `json:"validator"`
+	Signer          string           `json:"signer"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	NRecentBlocks   int              `json:"nRecentBlocks"`
+	Stake           int64            `json:"stake"`
+	IsJailed        bool             `json:"isJailed"`
+	UnjailableAfter *int64           `json:"unjailableAfter"`
+	IsActive        bool             `json:"isActive"`
+	Commission      float64          `json:"commission,string"`
+	Stats           []ValidatorStats `json:"stats"`
+}
+
+// ValidatorStats are the performance of a validator over Period, one of
+// "day", "week" and "month".
+type ValidatorStats struct {
+	Period         string
+	UptimeFraction float64
+	PredictedApr   float64
+	NSamples       int
+}
+
+type validatorStatsJSON struct {
+	UptimeFraction float64 `json:"uptimeFraction,string"`
+	PredictedApr   float64 `json:"predictedApr,string"`
+	NSamples       int     `json:"nSamples"`
+}
+
+func (s ValidatorStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{s.Period, validatorStatsJSON{
+		UptimeFraction: s.UptimeFraction,
+		PredictedApr:   s.PredictedApr,
+		NSamples:       s.NSamples,
+	}})
+}
+
+// UnmarshalJSON decodes the [period, stats] tuples of validator stats.
+func (s *ValidatorStats) UnmarshalJSON(data []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return fmt.Errorf("validator stats: expected 2 elements, got %d", len(tuple))
+	}
+
+	var stats validatorStatsJSON
+	if err := json.Unmarshal(tuple[0], &s.Period); err != nil {
+		return fmt.Errorf("validator stats period: %w", err)
+	}
+	if err := json.Unmarshal(tuple[1], &stats); err != nil {
+		return fmt.Errorf("validator stats %s: %w", s.Period, err)
+	}
+
+	s.UptimeFraction = stats.UptimeFraction
+	s.PredictedApr = stats.PredictedApr
+	s.NSamples = stats.NSamples
+	return nil
+}
+
+// ValidatorSummaries returns the validators of the Hyperliquid L1.
+func (i *Info) ValidatorSummaries() ([]ValidatorSummary, error) {
+	resp, err := i.client.post("/info", map[string]any{
+		"type": "validatorSummaries",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch validator summaries: %w", err)
+	}
+
+	var result []ValidatorSummary
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal validator summaries: %w", err)
+	}
+	return result, nil
+}
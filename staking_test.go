@@ -0,0 +1,85 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfo_ValidatorSummaries(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		return json.RawMessage(`[{
+			"validator": "0x5ac99df645f3414876c816caa18b2d234024b487",
+			"signer": "0x6c4e9e7d9b8b9d8b6f3a1c8a6d2e5f7a9b0c1d2e",
+			"name": "Hypurr",
+			"description": "Validator run by the Hypurr team",
+			"nRecentBlocks": 412,
+			"stake": 25000000000000,
+			"isJailed": false,
+			"unjailableAfter": null,
+			"isActive": true,
+			"commission": "0.04",
+			"stats": [
+				["day", {"uptimeFraction": "0.9995", "predictedApr": "0.0231", "nSamples": 1440}],
+				["week", {"uptimeFraction": "0.998", "predictedApr": "0.0229", "nSamples": 10080}]
+			]
+		}]`)
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	validators, err := info.ValidatorSummaries()
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+
+	validator := validators[0]
+	assert.Equal(t, "Hypurr", validator.Name)
+	assert.Equal(t, int64(25000000000000), validator.Stake)
+	assert.Equal(t, 0.04, validator.Commission)
+	assert.Nil(t, validator.UnjailableAfter)
+	assert.Equal(t, []ValidatorStats{
+		{Period: "day", UptimeFraction: 0.9995, PredictedApr: 0.0231, NSamples: 1440},
+		{Period: "week", UptimeFraction: 0.998, PredictedApr: 0.0229, NSamples: 10080},
+	}, validator.Stats)
+}
+
+func TestExchange_StakingActions(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	_, err := exchange.TokenDelegate(testValidator, 100000000, false)
+	require.NoError(t, err)
+	_, err = exchange.CDeposit(250000000)
+	require.NoError(t, err)
+	_, err = exchange.CWithdraw(50000000)
+	require.NoError(t, err)
+	_, err = exchange.CWithdraw(0)
+	assert.Error(t, err)
+
+	require.Len(t, requests, 3)
+	for _, req := range requests {
+		assert.NotContains(t, req, "vaultAddress")
+		action := req["action"].(map[string]any)
+		assert.Equal(t, "0x66eee", action["signatureChainId"])
+		assert.Equal(t, "Testnet", action["hyperliquidChain"])
+		assert.Equal(t, req["nonce"], action["nonce"])
+	}
+	assert.Equal(t, "tokenDelegate", requests[0]["action"].(map[string]any)["type"])
+	assert.Equal(t, testValidator, requests[0]["action"].(map[string]any)["validator"])
+	assert.Equal(t, "cDeposit", requests[1]["action"].(map[string]any)["type"])
+	assert.Equal(t, float64(50000000), requests[2]["action"].(map[string]any)["wei"])
+
+	// The signature covers the action as sent
+	action := requests[1]["action"].(map[string]any)
+	action["wei"] = uint64(action["wei"].(float64))
+	action["nonce"] = int64(action["nonce"].(float64))
+	hash, err := userSignedActionHash(action, stakingTransferFields, "HyperliquidTransaction:CDeposit")
+	require.NoError(t, err)
+
+	assert.Equal(t, crypto.PubkeyToAddress(exchange.privateKey.PublicKey), recoverTestSigner(t, hash, requests[1]["signature"]))
+}
@@ -0,0 +1,76 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSubAccountUser = "0x035605fc2f24d65300227189025e90a0d947f16c"
+
+func TestInfo_QuerySubAccounts(t *testing.T) {
+	server := newTestServer(t, func(req map[string]any) any {
+		fixture, err := os.ReadFile(filepath.Join("testdata", "sub_accounts.json"))
+		require.NoError(t, err)
+		return json.RawMessage(fixture)
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	subAccounts, err := info.QuerySubAccounts("0x8c967e73e7b15087c42a10d344cff4c96d877f1d")
+	require.NoError(t, err)
+	require.Len(t, subAccounts, 1)
+
+	subAccount := subAccounts[0]
+	assert.Equal(t, "market-maker", subAccount.Name)
+	assert.Equal(t, testSubAccountUser, subAccount.User)
+	assert.Equal(t, "29.78001", subAccount.ClearinghouseState.Withdrawable)
+	require.Len(t, subAccount.SpotState.Balances, 2)
+	assert.Equal(t, SpotBalance{Coin: "PURR", Token: 1, Total: 150, Hold: 50, EntryNtl: 27.75}, subAccount.SpotState.Balances[1])
+}
+
+func TestExchange_SubAccountActions(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		if req["action"].(map[string]any)["type"] == "createSubAccount" {
+			return map[string]any{"status": "ok", "response": map[string]any{"type": "createSubAccount", "data": testSubAccountUser}}
+		}
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	address, err := exchange.CreateSubAccount("market-maker")
+	require.NoError(t, err)
+	assert.Equal(t, testSubAccountUser, address)
+
+	_, err = exchange.SubAccountTransfer(testSubAccountUser, true, 25.5)
+	require.NoError(t, err)
+
+	_, err = exchange.SubAccountSpotTransfer(testSubAccountUser, false, "PURR", 12.5)
+	require.NoError(t, err)
+
+	_, err = exchange.CreateSubAccount("")
+	assert.Error(t, err)
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, map[string]any{"type": "createSubAccount", "name": "market-maker"}, requests[0]["action"])
+	assert.Equal(t, map[string]any{
+		"type":           "subAccountTransfer",
+		"subAccountUser": testSubAccountUser,
+		"isDeposit":      true,
+		"usd":            float64(25500000),
+	}, requests[1]["action"])
+	assert.Equal(t, map[string]any{
+		"type":           "subAccountSpotTransfer",
+		"subAccountUser": testSubAccountUser,
+		"isDeposit":      false,
+		"token":          "PURR:0xc1fb593aeffbeb02f85e0308e9956a90",
+		"amount":         "12.5",
+	}, requests[2]["action"])
+	for _, req := range requests {
+		assert.NotContains(t, req, "vaultAddress")
+	}
+}
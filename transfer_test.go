@@ -0,0 +1,168 @@
+package hyperliquid
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfo_SpotTokenID(t *testing.T) {
+	spotMeta := testSpotMeta()
+	spotMeta.Tokens = append(spotMeta.Tokens,
+		SpotTokenInfo{Name: "HYPE", Index: 2, TokenID: "0x0d01dc56dcaaca66ad901c959b4011ec"},
+		SpotTokenInfo{Name: "HYPE", Index: 3, TokenID: "0x7317beb7cceed72ef0b346074cc8e7ab", IsCanonical: true},
+	)
+	info := NewInfo(LocalAPIURL, true, testMeta(), spotMeta)
+
+	tests := []struct {
+		token   string
+		want    string
+		wantErr bool
+	}{
+		{token: "PURR", want: "PURR:0xc1fb593aeffbeb02f85e0308e9956a90"},
+		{token: "PURR:0xc1fb593aeffbeb02f85e0308e9956a90", want: "PURR:0xc1fb593aeffbeb02f85e0308e9956a90"},
+		{token: "PURR:0xC1FB593AEFFBEB02F85E0308E9956A90", want: "PURR:0xc1fb593aeffbeb02f85e0308e9956a90"},
+		{token: "HYPE", want: "HYPE:0x7317beb7cceed72ef0b346074cc8e7ab"},
+		{token: "HYPE:0x0d01dc56dcaaca66ad901c959b4011ec", want: "HYPE:0x0d01dc56dcaaca66ad901c959b4011ec"},
+		{token: "PURR:0x6d1e7cde53ba9467b783cb7c530ce054", wantErr: true},
+		{token: "FOO", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			tokenID, err := info.SpotTokenID(tt.token)
+			if tt.wantErr {
+				var validationErr ValidationError
+				assert.True(t, errors.As(err, &validationErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, tokenID)
+		})
+	}
+}
+
+func TestExchange_UsdClassTransfer(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+
+	_, err := exchange.UsdClassTransfer(125.5, true)
+	require.NoError(t, err)
+	_, err = exchange.UsdClassTransfer(-1, true)
+	assert.Error(t, err)
+
+	require.Len(t, requests, 1)
+	assert.NotContains(t, requests[0], "vaultAddress")
+	action := requests[0]["action"].(map[string]any)
+	assert.Equal(t, "usdClassTransfer", action["type"])
+	assert.Equal(t, "125.5 subaccount:"+testVaultAddress, action["amount"])
+	assert.Equal(t, true, action["toPerp"])
+	assert.Equal(t, requests[0]["nonce"], action["nonce"])
+	assert.Equal(t, "Testnet", action["hyperliquidChain"])
+}
+
+func TestExchange_SpotSend(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+	destination := "0x0000000000000000000000000000000000000def"
+
+	_, err := exchange.SpotSend(destination, "PURR", 1000)
+	require.NoError(t, err)
+	_, err = exchange.SpotSend(destination, "FOO", 1)
+	assert.Error(t, err)
+	_, err = exchange.SpotSend("0x1234", "PURR", 1)
+	assert.Error(t, err)
+
+	require.Len(t, requests, 1)
+	action := requests[0]["action"].(map[string]any)
+	assert.Equal(t, "spotSend", action["type"])
+	assert.Equal(t, destination, action["destination"])
+	assert.Equal(t, "PURR:0xc1fb593aeffbeb02f85e0308e9956a90", action["token"])
+	assert.Equal(t, "1000", action["amount"])
+	assert.Equal(t, requests[0]["nonce"], action["time"])
+	assert.NotContains(t, action, "nonce")
+}
+
+func TestUserSignedActionBuilders(t *testing.T) {
+	exchange := newTestExchange(t, func(map[string]any) any { return nil })
+
+	transfer, err := UsdClassTransferAction(12.5, true)
+	require.NoError(t, err)
+	assert.Equal(t, ActionFields{{"type", "usdClassTransfer"}, {"amount", "12.5"}, {"toPerp", true}}, transfer.Action)
+	assert.Equal(t, "nonce", transfer.NonceField)
+
+	spotSend, err := exchange.SpotSendAction("0x0000000000000000000000000000000000000def", "PURR", 2)
+	require.NoError(t, err)
+	assert.Equal(t, "PURR:0xc1fb593aeffbeb02f85e0308e9956a90", spotSend.Action.get("token"))
+	assert.Equal(t, "HyperliquidTransaction:SpotSend", spotSend.PrimaryType)
+
+	var validationErr ValidationError
+	_, err = UsdSendAction("0x1234", 1)
+	assert.True(t, errors.As(err, &validationErr))
+	_, err = UsdClassTransferAction(0, false)
+	assert.True(t, errors.As(err, &validationErr))
+}
+
+func TestValidateAddress(t *testing.T) {
+	tests := []struct {
+		address string
+		valid   bool
+	}{
+		{address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed", valid: true},
+		{address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", valid: true},
+		{address: "0x5AAEB6053F3E94C9B9A09F33669435E7EF1BEAED", valid: true},
+		{address: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeaEd", valid: false},
+		{address: "0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea", valid: false},
+		{address: "destination", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			err := validateAddress("destination", tt.address)
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			var validationErr ValidationError
+			require.True(t, errors.As(err, &validationErr))
+			assert.Equal(t, "destination", validationErr.Field)
+		})
+	}
+}
+
+func TestExchange_Withdraw3(t *testing.T) {
+	var requests []map[string]any
+	exchange := newTestExchange(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		return map[string]any{"status": "ok", "response": map[string]any{"type": "default"}}
+	})
+	destination := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	result, err := exchange.Withdraw3(destination, 250)
+	require.NoError(t, err)
+	assert.Equal(t, WithdrawalFee, result.Fee)
+	assert.Equal(t, 249.0, result.Received)
+
+	require.Len(t, requests, 1)
+	assert.NotContains(t, requests[0], "vaultAddress")
+	action := requests[0]["action"].(map[string]any)
+	assert.Equal(t, "withdraw3", action["type"])
+	assert.Equal(t, destination, action["destination"])
+	assert.Equal(t, "250", action["amount"])
+	assert.Equal(t, float64(result.Time), action["time"])
+	assert.Equal(t, requests[0]["nonce"], action["time"])
+
+	_, err = exchange.Withdraw3(destination, WithdrawalFee)
+	assert.Error(t, err)
+	_, err = exchange.Withdraw3("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeaEd", 250)
+	assert.Error(t, err)
+	assert.Len(t, requests, 1)
+}
@@ -1,21 +1,86 @@
 package hyperliquid
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 //go:generate easyjson -all types.go
 
-type Side string
+type AssetInfo struct {
+	Name          string `json:"name"`
+	SzDecimals    int    `json:"szDecimals"`
+	MaxLeverage   int    `json:"maxLeverage"`
+	OnlyIsolated  bool   `json:"onlyIsolated,omitempty"`
+	IsDelisted    bool   `json:"isDelisted,omitempty"`
+	MarginTableID int    `json:"marginTableId"`
+}
 
-const (
-	SideAsk Side = "A"
-	SideBid Side = "B"
-)
+type MarginTier struct {
+	LowerBound  float64 `json:"lowerBound,string"`
+	MaxLeverage int     `json:"maxLeverage"`
+}
 
-type AssetInfo struct {
-	Name       string `json:"name"`
-	SzDecimals int    `json:"szDecimals"`
+type MarginTable struct {
+	Description string       `json:"description"`
+	MarginTiers []MarginTier `json:"marginTiers"`
+}
+
+// MaxLeverageAt returns the maximum leverage allowed for a position of the
+// given notional value, i.e. the leverage of the highest tier whose lower
+// bound does not exceed it.
+func (t MarginTable) MaxLeverageAt(notional float64) int {
+	leverage := 0
+	for _, tier := range t.MarginTiers {
+		if tier.LowerBound > notional {
+			break
+		}
+		leverage = tier.MaxLeverage
+	}
+	return leverage
+}
+
+// MarginTableEntry is a single element of Meta.MarginTables, which the API
+// encodes as an [id, table] tuple.
+//
+//easyjson:skip
+type MarginTableEntry struct {
+	ID    int
+	Table MarginTable
+}
+
+func (e MarginTableEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{e.ID, e.Table})
+}
+
+func (e *MarginTableEntry) UnmarshalJSON(data []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return fmt.Errorf("margin table entry: expected 2 elements, got %d", len(tuple))
+	}
+	if err := json.Unmarshal(tuple[0], &e.ID); err != nil {
+		return fmt.Errorf("margin table entry id: %w", err)
+	}
+	if err := json.Unmarshal(tuple[1], &e.Table); err != nil {
+		return fmt.Errorf("margin table entry table: %w", err)
+	}
+	return nil
 }
 
 type Meta struct {
-	Universe []AssetInfo `json:"universe"`
+	Universe     []AssetInfo        `json:"universe"`
+	MarginTables []MarginTableEntry `json:"marginTables"`
+}
+
+// PerpDex describes a builder-deployed perp dex (HIP-3).
+type PerpDex struct {
+	Name          string  `json:"name"`
+	FullName      string  `json:"full_name"`
+	Deployer      string  `json:"deployer"`
+	OracleUpdater *string `json:"oracle_updater"`
 }
 
 type SpotAssetInfo struct {
@@ -63,7 +128,7 @@ type WsMsg struct {
 
 type OrderRequest struct {
 	Coin       string    `json:"coin"`
-	IsBuy      bool      `json:"is_buy"`
+	Side       Side      `json:"side"`
 	Size       float64   `json:"sz"`
 	LimitPx    float64   `json:"limit_px"`
 	OrderType  OrderType `json:"order_type"`
@@ -80,9 +145,10 @@ type LimitOrderType struct {
 	Tif string `json:"tif"` // "Alo", "Ioc", "Gtc"
 }
 
+// TriggerOrderType fields are in the order Hyperliquid hashes them.
 type TriggerOrderType struct {
-	TriggerPx string `json:"triggerPx"`
 	IsMarket  bool   `json:"isMarket"`
+	TriggerPx string `json:"triggerPx"`
 	Tpsl      string `json:"tpsl"` // "tp" or "sl"
 }
 
@@ -91,16 +157,29 @@ type BuilderInfo struct {
 	Fee     int    `json:"f"`
 }
 
+// OrderWire fields are in the order Hyperliquid hashes them.
 type OrderWire struct {
 	Asset      int         `json:"a"`
 	IsBuy      bool        `json:"b"`
-	Size       string      `json:"s"`
 	LimitPx    string      `json:"p"`
+	Size       string      `json:"s"`
 	ReduceOnly bool        `json:"r"`
 	Type       OrderTypeV2 `json:"t"`
 	Cloid      string      `json:"c,omitempty"`
 }
 
+// CancelWire fields are in the order Hyperliquid hashes them.
+type CancelWire struct {
+	Asset int   `json:"a"`
+	Oid   int64 `json:"o"`
+}
+
+// CancelByCloidWire fields are in the order Hyperliquid hashes them.
+type CancelByCloidWire struct {
+	Asset int    `json:"asset"`
+	Cloid string `json:"cloid"`
+}
+
 type OrderTypeV2 struct {
 	Limit   *LimitOrderType   `json:"limit,omitempty"`
 	Trigger *TriggerOrderType `json:"trigger,omitempty"`
@@ -151,10 +151,10 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid1(in *jlexer.Lexer, out *T
 			continue
 		}
 		switch key {
-		case "triggerPx":
-			out.TriggerPx = string(in.String())
 		case "isMarket":
 			out.IsMarket = bool(in.Bool())
+		case "triggerPx":
+			out.TriggerPx = string(in.String())
 		case "tpsl":
 			out.Tpsl = string(in.String())
 		default:
@@ -172,14 +172,14 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid1(out *jwriter.Writer, in
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"triggerPx\":"
+		const prefix string = ",\"isMarket\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.TriggerPx))
+		out.Bool(bool(in.IsMarket))
 	}
 	{
-		const prefix string = ",\"isMarket\":"
+		const prefix string = ",\"triggerPx\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsMarket))
+		out.String(string(in.TriggerPx))
 	}
 	{
 		const prefix string = ",\"tpsl\":"
@@ -720,7 +720,106 @@ func (v *SpotAssetCtx) UnmarshalJSON(data []byte) error {
 func (v *SpotAssetCtx) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid5(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(in *jlexer.Lexer, out *OrderWire) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(in *jlexer.Lexer, out *PerpDex) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "full_name":
+			out.FullName = string(in.String())
+		case "deployer":
+			out.Deployer = string(in.String())
+		case "oracle_updater":
+			if in.IsNull() {
+				in.Skip()
+				out.OracleUpdater = nil
+			} else {
+				if out.OracleUpdater == nil {
+					out.OracleUpdater = new(string)
+				}
+				*out.OracleUpdater = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(out *jwriter.Writer, in PerpDex) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"full_name\":"
+		out.RawString(prefix)
+		out.String(string(in.FullName))
+	}
+	{
+		const prefix string = ",\"deployer\":"
+		out.RawString(prefix)
+		out.String(string(in.Deployer))
+	}
+	{
+		const prefix string = ",\"oracle_updater\":"
+		out.RawString(prefix)
+		if in.OracleUpdater == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.OracleUpdater))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PerpDex) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PerpDex) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PerpDex) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PerpDex) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(in *jlexer.Lexer, out *OrderWire) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -743,10 +842,10 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(in *jlexer.Lexer, out *O
 			out.Asset = int(in.Int())
 		case "b":
 			out.IsBuy = bool(in.Bool())
-		case "s":
-			out.Size = string(in.String())
 		case "p":
 			out.LimitPx = string(in.String())
+		case "s":
+			out.Size = string(in.String())
 		case "r":
 			out.ReduceOnly = bool(in.Bool())
 		case "t":
@@ -763,7 +862,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(in *jlexer.Lexer, out *O
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(out *jwriter.Writer, in OrderWire) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(out *jwriter.Writer, in OrderWire) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -778,14 +877,14 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(out *jwriter.Writer, in
 		out.Bool(bool(in.IsBuy))
 	}
 	{
-		const prefix string = ",\"s\":"
+		const prefix string = ",\"p\":"
 		out.RawString(prefix)
-		out.String(string(in.Size))
+		out.String(string(in.LimitPx))
 	}
 	{
-		const prefix string = ",\"p\":"
+		const prefix string = ",\"s\":"
 		out.RawString(prefix)
-		out.String(string(in.LimitPx))
+		out.String(string(in.Size))
 	}
 	{
 		const prefix string = ",\"r\":"
@@ -808,27 +907,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v OrderWire) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderWire) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid6(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderWire) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderWire) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid6(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(in *jlexer.Lexer, out *OrderTypeV2) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(in *jlexer.Lexer, out *OrderTypeV2) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -877,7 +976,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(in *jlexer.Lexer, out *O
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(out *jwriter.Writer, in OrderTypeV2) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(out *jwriter.Writer, in OrderTypeV2) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -903,27 +1002,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v OrderTypeV2) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderTypeV2) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid7(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderTypeV2) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderTypeV2) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid7(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(in *jlexer.Lexer, out *OrderType) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(in *jlexer.Lexer, out *OrderType) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -972,7 +1071,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(in *jlexer.Lexer, out *O
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(out *jwriter.Writer, in OrderType) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(out *jwriter.Writer, in OrderType) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -998,27 +1097,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v OrderType) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid8(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderType) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid8(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(in *jlexer.Lexer, out *OrderRequest) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(in *jlexer.Lexer, out *OrderRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1039,8 +1138,10 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(in *jlexer.Lexer, out *O
 		switch key {
 		case "coin":
 			out.Coin = string(in.String())
-		case "is_buy":
-			out.IsBuy = bool(in.Bool())
+		case "side":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Side).UnmarshalJSON(data))
+			}
 		case "sz":
 			out.Size = float64(in.Float64())
 		case "limit_px":
@@ -1069,7 +1170,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(in *jlexer.Lexer, out *O
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(out *jwriter.Writer, in OrderRequest) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(out *jwriter.Writer, in OrderRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1079,9 +1180,9 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(out *jwriter.Writer, in
 		out.String(string(in.Coin))
 	}
 	{
-		const prefix string = ",\"is_buy\":"
+		const prefix string = ",\"side\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsBuy))
+		out.Raw((in.Side).MarshalJSON())
 	}
 	{
 		const prefix string = ",\"sz\":"
@@ -1114,27 +1215,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v OrderRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v OrderRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid9(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *OrderRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *OrderRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid9(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(in *jlexer.Lexer, out *Meta) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(in *jlexer.Lexer, out *Meta) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1161,7 +1262,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(in *jlexer.Lexer, out *
 				in.Delim('[')
 				if out.Universe == nil {
 					if !in.IsDelim(']') {
-						out.Universe = make([]AssetInfo, 0, 2)
+						out.Universe = make([]AssetInfo, 0, 1)
 					} else {
 						out.Universe = []AssetInfo{}
 					}
@@ -1176,6 +1277,31 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(in *jlexer.Lexer, out *
 				}
 				in.Delim(']')
 			}
+		case "marginTables":
+			if in.IsNull() {
+				in.Skip()
+				out.MarginTables = nil
+			} else {
+				in.Delim('[')
+				if out.MarginTables == nil {
+					if !in.IsDelim(']') {
+						out.MarginTables = make([]MarginTableEntry, 0, 1)
+					} else {
+						out.MarginTables = []MarginTableEntry{}
+					}
+				} else {
+					out.MarginTables = (out.MarginTables)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 MarginTableEntry
+					if data := in.Raw(); in.Ok() {
+						in.AddError((v13).UnmarshalJSON(data))
+					}
+					out.MarginTables = append(out.MarginTables, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1186,7 +1312,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(out *jwriter.Writer, in Meta) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(out *jwriter.Writer, in Meta) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1197,11 +1323,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(out *jwriter.Writer, in
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v13, v14 := range in.Universe {
-				if v13 > 0 {
+			for v14, v15 := range in.Universe {
+				if v14 > 0 {
 					out.RawByte(',')
 				}
-				(v14).MarshalEasyJSON(out)
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"marginTables\":"
+		out.RawString(prefix)
+		if in.MarginTables == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v16, v17 := range in.MarginTables {
+				if v16 > 0 {
+					out.RawByte(',')
+				}
+				out.Raw((v17).MarshalJSON())
 			}
 			out.RawByte(']')
 		}
@@ -1212,27 +1354,205 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v Meta) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Meta) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid10(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Meta) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Meta) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid10(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(in *jlexer.Lexer, out *MarginTier) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "lowerBound":
+			out.LowerBound = float64(in.Float64Str())
+		case "maxLeverage":
+			out.MaxLeverage = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(out *jwriter.Writer, in MarginTier) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"lowerBound\":"
+		out.RawString(prefix[1:])
+		out.Float64Str(float64(in.LowerBound))
+	}
+	{
+		const prefix string = ",\"maxLeverage\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxLeverage))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginTier) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginTier) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginTier) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginTier) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(in *jlexer.Lexer, out *MarginTable) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "description":
+			out.Description = string(in.String())
+		case "marginTiers":
+			if in.IsNull() {
+				in.Skip()
+				out.MarginTiers = nil
+			} else {
+				in.Delim('[')
+				if out.MarginTiers == nil {
+					if !in.IsDelim(']') {
+						out.MarginTiers = make([]MarginTier, 0, 4)
+					} else {
+						out.MarginTiers = []MarginTier{}
+					}
+				} else {
+					out.MarginTiers = (out.MarginTiers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v18 MarginTier
+					(v18).UnmarshalEasyJSON(in)
+					out.MarginTiers = append(out.MarginTiers, v18)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(in *jlexer.Lexer, out *LimitOrderType) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(out *jwriter.Writer, in MarginTable) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"description\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Description))
+	}
+	{
+		const prefix string = ",\"marginTiers\":"
+		out.RawString(prefix)
+		if in.MarginTiers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v19, v20 := range in.MarginTiers {
+				if v19 > 0 {
+					out.RawByte(',')
+				}
+				(v20).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v MarginTable) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v MarginTable) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *MarginTable) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *MarginTable) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(in *jlexer.Lexer, out *LimitOrderType) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1263,7 +1583,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(out *jwriter.Writer, in LimitOrderType) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(out *jwriter.Writer, in LimitOrderType) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1278,27 +1598,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v LimitOrderType) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v LimitOrderType) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid11(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *LimitOrderType) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *LimitOrderType) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid11(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(in *jlexer.Lexer, out *EvmContract) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid15(in *jlexer.Lexer, out *EvmContract) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1331,7 +1651,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(out *jwriter.Writer, in EvmContract) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid15(out *jwriter.Writer, in EvmContract) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1351,27 +1671,173 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v EvmContract) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v EvmContract) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid12(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *EvmContract) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *EvmContract) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid12(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid15(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid16(in *jlexer.Lexer, out *CancelWire) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "a":
+			out.Asset = int(in.Int())
+		case "o":
+			out.Oid = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid16(out *jwriter.Writer, in CancelWire) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"a\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Asset))
+	}
+	{
+		const prefix string = ",\"o\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Oid))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CancelWire) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid16(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CancelWire) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid16(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CancelWire) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid16(&r, v)
+	return r.Error()
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(in *jlexer.Lexer, out *BuilderInfo) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CancelWire) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid16(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid17(in *jlexer.Lexer, out *CancelByCloidWire) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "asset":
+			out.Asset = int(in.Int())
+		case "cloid":
+			out.Cloid = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid17(out *jwriter.Writer, in CancelByCloidWire) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"asset\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Asset))
+	}
+	{
+		const prefix string = ",\"cloid\":"
+		out.RawString(prefix)
+		out.String(string(in.Cloid))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CancelByCloidWire) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid17(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CancelByCloidWire) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid17(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CancelByCloidWire) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid17(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CancelByCloidWire) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid17(l, v)
+}
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid18(in *jlexer.Lexer, out *BuilderInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1404,7 +1870,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(out *jwriter.Writer, in BuilderInfo) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid18(out *jwriter.Writer, in BuilderInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1424,27 +1890,27 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(out *jwriter.Writer, in
 // MarshalJSON supports json.Marshaler interface
 func (v BuilderInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v BuilderInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid13(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *BuilderInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *BuilderInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid13(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid18(l, v)
 }
-func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(in *jlexer.Lexer, out *AssetInfo) {
+func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid19(in *jlexer.Lexer, out *AssetInfo) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1467,6 +1933,14 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(in *jlexer.Lexer, out *
 			out.Name = string(in.String())
 		case "szDecimals":
 			out.SzDecimals = int(in.Int())
+		case "maxLeverage":
+			out.MaxLeverage = int(in.Int())
+		case "onlyIsolated":
+			out.OnlyIsolated = bool(in.Bool())
+		case "isDelisted":
+			out.IsDelisted = bool(in.Bool())
+		case "marginTableId":
+			out.MarginTableID = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1477,7 +1951,7 @@ func easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(in *jlexer.Lexer, out *
 		in.Consumed()
 	}
 }
-func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(out *jwriter.Writer, in AssetInfo) {
+func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid19(out *jwriter.Writer, in AssetInfo) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1491,29 +1965,49 @@ func easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(out *jwriter.Writer, in
 		out.RawString(prefix)
 		out.Int(int(in.SzDecimals))
 	}
+	{
+		const prefix string = ",\"maxLeverage\":"
+		out.RawString(prefix)
+		out.Int(int(in.MaxLeverage))
+	}
+	if in.OnlyIsolated {
+		const prefix string = ",\"onlyIsolated\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.OnlyIsolated))
+	}
+	if in.IsDelisted {
+		const prefix string = ",\"isDelisted\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsDelisted))
+	}
+	{
+		const prefix string = ",\"marginTableId\":"
+		out.RawString(prefix)
+		out.Int(int(in.MarginTableID))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AssetInfo) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(&w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AssetInfo) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid14(w, v)
+	easyjson6601e8cdEncodeGithubComWeeaaGoHyperliquid19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AssetInfo) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(&r, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AssetInfo) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid14(l, v)
+	easyjson6601e8cdDecodeGithubComWeeaaGoHyperliquid19(l, v)
 }
@@ -285,6 +285,71 @@ func TestSpotAssetCtx_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestMeta_UnmarshalJSON(t *testing.T) {
+	jsonData := `{
+		"universe": [
+			{"szDecimals":5,"name":"BTC","maxLeverage":40,"marginTableId":56},
+			{"szDecimals":1,"name":"MATIC","maxLeverage":20,"marginTableId":20,"isDelisted":true},
+			{"szDecimals":0,"name":"kPEPE","maxLeverage":10,"marginTableId":10,"onlyIsolated":true}
+		],
+		"marginTables": [
+			[56,{"description":"tiered 40x","marginTiers":[{"lowerBound":"0.0","maxLeverage":40},{"lowerBound":"150000000.0","maxLeverage":20}]}],
+			[10,{"description":"","marginTiers":[{"lowerBound":"0.0","maxLeverage":10}]}]
+		]
+	}`
+
+	var meta Meta
+	require.NoError(t, json.Unmarshal([]byte(jsonData), &meta))
+
+	require.Len(t, meta.Universe, 3)
+	assert.Equal(t, AssetInfo{
+		Name:          "BTC",
+		SzDecimals:    5,
+		MaxLeverage:   40,
+		MarginTableID: 56,
+	}, meta.Universe[0])
+	assert.True(t, meta.Universe[1].IsDelisted)
+	assert.True(t, meta.Universe[2].OnlyIsolated)
+
+	require.Len(t, meta.MarginTables, 2)
+	assert.Equal(t, 56, meta.MarginTables[0].ID)
+	assert.Equal(t, "tiered 40x", meta.MarginTables[0].Table.Description)
+	assert.Equal(t, []MarginTier{
+		{LowerBound: 0, MaxLeverage: 40},
+		{LowerBound: 150000000, MaxLeverage: 20},
+	}, meta.MarginTables[0].Table.MarginTiers)
+
+	// Round-trip keeps the tuple encoding
+	jsonOut, err := json.Marshal(meta.MarginTables[1])
+	require.NoError(t, err)
+	assert.JSONEq(t, `[10,{"description":"","marginTiers":[{"lowerBound":"0","maxLeverage":10}]}]`, string(jsonOut))
+}
+
+func TestMarginTable_MaxLeverageAt(t *testing.T) {
+	table := MarginTable{
+		MarginTiers: []MarginTier{
+			{LowerBound: 0, MaxLeverage: 40},
+			{LowerBound: 150000000, MaxLeverage: 20},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		notional float64
+		expected int
+	}{
+		{name: "first_tier", notional: 1000, expected: 40},
+		{name: "tier_boundary", notional: 150000000, expected: 20},
+		{name: "above_last_tier", notional: 500000000, expected: 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, table.MaxLeverageAt(tt.notional))
+		})
+	}
+}
+
 // Helper function to create string pointers
 func stringPtr(s string) *string {
 	return &s
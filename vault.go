@@ -0,0 +1,174 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// VaultDetails is the state of a vault as shown on its Hyperliquid page.
+type VaultDetails struct {
+	Name                  string            `json:"name"`
+	VaultAddress          string            `json:"vaultAddress"`
+	Leader                string            `json:"leader"`
+	Description           string            `json:"description"`
+	Portfolio             []VaultPortfolio  `json:"portfolio"`
+	Apr                   float64           `json:"apr"`
+	FollowerState         *VaultFollower    `json:"followerState"`
+	LeaderFraction        float64           `json:"leaderFraction"`
+	LeaderCommission      float64           `json:"leaderCommission"`
+	Followers             []VaultFollower   `json:"followers"`
+	MaxDistributable      float64           `json:"maxDistributable"`
+	MaxWithdrawable       float64           `json:"maxWithdrawable"`
+	IsClosed              bool              `json:"isClosed"`
+	Relationship          VaultRelationship `json:"relationship"`
+	AllowDeposits         bool              `json:"allowDeposits"`
+	AlwaysCloseOnWithdraw bool              `json:"alwaysCloseOnWithdraw"`
+}
+
+// VaultRelationship tells whether a vault is standalone ("normal"), a parent
+// vault or the child of one.
+type VaultRelationship struct {
+	Type string                 `json:"type"`
+	Data *VaultRelationshipData `json:"data,omitempty"`
+}
+
+type VaultRelationshipData struct {
+	Parent         string   `json:"parent,omitempty"`
+	ChildAddresses []string `json:"childAddresses,omitempty"`
+}
+
+// VaultFollower is a depositor of a vault. The leader is a follower too.
+type VaultFollower struct {
+	User           string  `json:"user"`
+	VaultEquity    float64 `json:"vaultEquity,string"`
+	Pnl            float64 `json:"pnl,string"`
+	AllTimePnl     float64 `json:"allTimePnl,string"`
+	DaysFollowing  int     `json:"daysFollowing"`
+	VaultEntryTime int64   `json:"vaultEntryTime"`
+	LockupUntil    int64   `json:"lockupUntil"`
+}
+
+// VaultPortfolio is the history of a vault over Period, one of "day",
+// "week", "month", "allTime" and their "perp" prefixed variants.
+type VaultPortfolio struct {
+	Period              string
+	AccountValueHistory []ValuePoint
+	PnlHistory          []ValuePoint
+	Volume              float64
+}
+
+// ValuePoint is a value at a time, in milliseconds.
+type ValuePoint struct {
+	Time  int64
+	Value float64
+}
+
+// UserVaultEquity is the equity of a user in a vault.
+type UserVaultEquity struct {
+	VaultAddress         string  `json:"vaultAddress"`
+	Equity               float64 `json:"equity,string"`
+	LockedUntilTimestamp int64   `json:"lockedUntilTimestamp"`
+}
+
+type vaultPortfolioJSON struct {
+	AccountValueHistory []ValuePoint `json:"accountValueHistory"`
+	PnlHistory          []ValuePoint `json:"pnlHistory"`
+	Vlm                 float64      `json:"vlm,string"`
+}
+
+// UnmarshalJSON decodes the [period, history] tuples of the portfolio.
+func (p *VaultPortfolio) UnmarshalJSON(data []byte) error {
+	var tuple [2]json.RawMessage
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+
+	var history vaultPortfolioJSON
+	if err := json.Unmarshal(tuple[0], &p.Period); err != nil {
+		return fmt.Errorf("vault portfolio period: %w", err)
+	}
+	if err := json.Unmarshal(tuple[1], &history); err != nil {
+		return fmt.Errorf("vault portfolio %s: %w", p.Period, err)
+	}
+
+	p.AccountValueHistory = history.AccountValueHistory
+	p.PnlHistory = history.PnlHistory
+	p.Volume = history.Vlm
+	return nil
+}
+
+func (p VaultPortfolio) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{p.Period, vaultPortfolioJSON{
+		AccountValueHistory: p.AccountValueHistory,
+		PnlHistory:          p.PnlHistory,
+		Vlm:                 p.Volume,
+	}})
+}
+
+// UnmarshalJSON decodes the [time, "value"] tuples of portfolio histories.
+func (v *ValuePoint) UnmarshalJSON(data []byte) error {
+	var tuple [2]json.RawMessage
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+
+	var value string
+	if err := json.Unmarshal(tuple[0], &v.Time); err != nil {
+		return fmt.Errorf("value point time: %w", err)
+	}
+	if err := json.Unmarshal(tuple[1], &value); err != nil {
+		return fmt.Errorf("value point value: %w", err)
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return fmt.Errorf("value point value: %w", err)
+	}
+	v.Value = parsed
+	return nil
+}
+
+func (v ValuePoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal([2]any{v.Time, strconv.FormatFloat(v.Value, 'f', -1, 64)})
+}
+
+// VaultDetails returns the details of a vault. When user is set, the
+// FollowerState of the result is the deposit of that user.
+func (i *Info) VaultDetails(vaultAddr, user string) (*VaultDetails, error) {
+	req := map[string]any{
+		"type":         "vaultDetails",
+		"vaultAddress": vaultAddr,
+	}
+	if user != "" {
+		req["user"] = user
+	}
+
+	resp, err := i.client.post("/info", req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch vault details: %w", err)
+	}
+
+	var result VaultDetails
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vault details: %w", err)
+	}
+	return &result, nil
+}
+
+// UserVaultEquities returns the vaults a user deposited into.
+func (i *Info) UserVaultEquities(user string) ([]UserVaultEquity, error) {
+	resp, err := i.client.post("/info", map[string]any{
+		"type": "userVaultEquities",
+		"user": user,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user vault equities: %w", err)
+	}
+
+	var result []UserVaultEquity
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal user vault equities: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfo_VaultQueries(t *testing.T) {
+	var requests []map[string]any
+	server := newTestServer(t, func(req map[string]any) any {
+		requests = append(requests, req)
+		if req["type"] == "userVaultEquities" {
+			return json.RawMessage(`[{"vaultAddress":"0xdfc24b077bc1425ad1dea75bcb6f8158e10df303","equity":"1520.75","lockedUntilTimestamp":1719100800000}]`)
+		}
+		fixture, err := os.ReadFile(filepath.Join("testdata", "vault_details.json"))
+		require.NoError(t, err)
+		return json.RawMessage(fixture)
+	})
+	info := NewInfo(server.URL, true, testMeta(), testSpotMeta())
+
+	t.Run("vault_details", func(t *testing.T) {
+		details, err := info.VaultDetails("0xdfc24b077bc1425ad1dea75bcb6f8158e10df303", "0xabc")
+		require.NoError(t, err)
+		assert.Equal(t, "0xabc", requests[len(requests)-1]["user"])
+
+		assert.Equal(t, 0.3612, details.Apr)
+		assert.Equal(t, 0.1023, details.LeaderFraction)
+		require.Len(t, details.Portfolio, 2)
+		assert.Equal(t, "day", details.Portfolio[0].Period)
+		assert.Equal(t, 1523400.25, details.Portfolio[0].Volume)
+		assert.Equal(t, []ValuePoint{{Time: 1719792000000, Value: 0}, {Time: 1719795600000, Value: 250.5}}, details.Portfolio[0].PnlHistory)
+		require.NotNil(t, details.FollowerState)
+		assert.Equal(t, 1520.75, details.FollowerState.VaultEquity)
+		require.Len(t, details.Followers, 1)
+		assert.Equal(t, 300, details.Followers[0].DaysFollowing)
+		assert.Equal(t, "parent", details.Relationship.Type)
+		require.NotNil(t, details.Relationship.Data)
+		assert.Len(t, details.Relationship.Data.ChildAddresses, 1)
+	})
+
+	t.Run("user_vault_equities", func(t *testing.T) {
+		equities, err := info.UserVaultEquities("0xabc")
+		require.NoError(t, err)
+		assert.Equal(t, []UserVaultEquity{{
+			VaultAddress:         "0xdfc24b077bc1425ad1dea75bcb6f8158e10df303",
+			Equity:               1520.75,
+			LockedUntilTimestamp: 1719100800000,
+		}}, equities)
+	})
+}
+
+func TestVaultPortfolio_MarshalJSON(t *testing.T) {
+	portfolio := VaultPortfolio{
+		Period:              "week",
+		AccountValueHistory: []ValuePoint{{Time: 1, Value: 1000.5}},
+		PnlHistory:          []ValuePoint{{Time: 1, Value: -2}},
+		Volume:              12,
+	}
+
+	data, err := json.Marshal(portfolio)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["week",{"accountValueHistory":[[1,"1000.5"]],"pnlHistory":[[1,"-2"]],"vlm":"12"}]`, string(data))
+
+	var decoded VaultPortfolio
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, portfolio, decoded)
+}
@@ -0,0 +1,96 @@
+package hyperliquid
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// RecoverL1ActionSigner returns the address that signed an L1 action, as sent
+// to /exchange with the given nonce, vault address and expiry. The action is
+// hashed in the order of its JSON fields, so actions of received payloads are
+// best passed as their json.RawMessage, which keeps it.
+func RecoverL1ActionSigner(
+	action any,
+	vaultAddress string,
+	timestamp int64,
+	expiresAfter *int64,
+	isMainnet bool,
+	signature Signature,
+) (common.Address, error) {
+	hash, err := l1ActionHash(action, vaultAddress, timestamp, expiresAfter, isMainnet)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return recoverSigner(hash, signature)
+}
+
+// VerifyL1Action checks that an L1 action was signed by signer, returning an
+// error wrapping ErrSignatureMismatch otherwise.
+func VerifyL1Action(
+	action any,
+	vaultAddress string,
+	timestamp int64,
+	expiresAfter *int64,
+	isMainnet bool,
+	signature Signature,
+	signer common.Address,
+) error {
+	recovered, err := RecoverL1ActionSigner(action, vaultAddress, timestamp, expiresAfter, isMainnet, signature)
+	if err != nil {
+		return err
+	}
+	return checkSigner(recovered, signer)
+}
+
+// RecoverUserSignedActionSigner returns the address that signed a user-signed
+// action, as sent to /exchange with its hyperliquidChain field.
+func RecoverUserSignedActionSigner(
+	action map[string]any,
+	fields []EIP712Field,
+	primaryType string,
+	signature Signature,
+) (common.Address, error) {
+	hash, err := userSignedActionHash(action, fields, primaryType)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return recoverSigner(hash, signature)
+}
+
+// VerifyUserSignedAction checks that a user-signed action was signed by
+// signer, returning an error wrapping ErrSignatureMismatch otherwise.
+func VerifyUserSignedAction(
+	action map[string]any,
+	fields []EIP712Field,
+	primaryType string,
+	signature Signature,
+	signer common.Address,
+) error {
+	recovered, err := RecoverUserSignedActionSigner(action, fields, primaryType, signature)
+	if err != nil {
+		return err
+	}
+	return checkSigner(recovered, signer)
+}
+
+// recoverSigner returns the address that signed hash with signature.
+func recoverSigner(hash []byte, signature Signature) (common.Address, error) {
+	// SigToPub expects a V of 0 or 1
+	sig := signature.Bytes()
+	sig[64] -= 27
+
+	publicKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+func checkSigner(recovered, signer common.Address) error {
+	if recovered != signer {
+		return fmt.Errorf("%w: signed by %s, expected %s", ErrSignatureMismatch, recovered.Hex(), signer.Hex())
+	}
+	return nil
+}
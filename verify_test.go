@@ -0,0 +1,201 @@
+package hyperliquid
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyL1Action(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	action := map[string]any{"type": "updateLeverage", "asset": 0, "isCross": true, "leverage": 5}
+	expiresAfter := int64(1700000030000)
+	sig, err := SignL1ActionWithExpiry(privateKey, action, testVaultAddress, 1700000000000, &expiresAfter, true)
+	require.NoError(t, err)
+
+	recovered, err := RecoverL1ActionSigner(action, testVaultAddress, 1700000000000, &expiresAfter, true, sig)
+	require.NoError(t, err)
+	assert.Equal(t, signer, recovered)
+	assert.NoError(t, VerifyL1Action(action, testVaultAddress, 1700000000000, &expiresAfter, true, sig, signer))
+
+	tests := []struct {
+		name         string
+		vaultAddress string
+		nonce        int64
+		expiresAfter *int64
+		isMainnet    bool
+	}{
+		{"other_vault", "", 1700000000000, &expiresAfter, true},
+		{"other_nonce", testVaultAddress, 1700000000001, &expiresAfter, true},
+		{"no_expiry", testVaultAddress, 1700000000000, nil, true},
+		{"testnet", testVaultAddress, 1700000000000, &expiresAfter, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyL1Action(action, tt.vaultAddress, tt.nonce, tt.expiresAfter, tt.isMainnet, sig, signer)
+			assert.True(t, errors.Is(err, ErrSignatureMismatch))
+		})
+	}
+
+	err = VerifyL1Action(action, testVaultAddress, 1700000000000, &expiresAfter, true, sig, common.HexToAddress(testVaultAddress))
+	assert.True(t, errors.Is(err, ErrSignatureMismatch))
+}
+
+func TestRecoverL1ActionSigner_SDKPayloads(t *testing.T) {
+	// Address of testSDKKey, which signed these payloads with the Python SDK
+	signer := common.HexToAddress("0x14791697260E4c9A71f18484C9f997B308e59325")
+
+	tests := []struct {
+		name         string
+		action       string
+		vaultAddress string
+		isMainnet    bool
+		signature    Signature
+	}{
+		{
+			name:      "mainnet",
+			action:    `{"type":"dummy","num":100000000000}`,
+			isMainnet: true,
+			signature: Signature{
+				R: common.HexToHash("0x53749d5b30552aeb2fca34b530185976545bb22d0b3ce6f62e31be961a59298"),
+				S: common.HexToHash("0x755c40ba9bf05223521753995abb2f73ab3229be8ec921f350cb447e384d8ed8"),
+				V: 27,
+			},
+		},
+		{
+			name:         "vault",
+			action:       `{"type":"dummy","num":100000000000}`,
+			vaultAddress: "0x1719884eb866cb12b2287399b15f7db5e7d775ea",
+			isMainnet:    true,
+			signature: Signature{
+				R: common.HexToHash("0x3c548db75e479f8012acf3000ca3a6b05606bc2ec0c29c50c515066a326239"),
+				S: common.HexToHash("0x4d402be7396ce74fbba3795769cda45aec00dc3125a984f2a9f23177b190da2c"),
+				V: 28,
+			},
+		},
+		{
+			name:   "order_testnet",
+			action: `{"type":"order","orders":[{"a":1,"b":true,"p":"100","s":"100","r":false,"t":{"limit":{"tif":"Gtc"}}}],"grouping":"na"}`,
+			signature: Signature{
+				R: common.HexToHash("0x82b2ba28e76b3d761093aaded1b1cdad4960b3af30212b343fb2e6cdfa4e3d54"),
+				S: common.HexToHash("0x6b53878fc99d26047f4d7e8c90eb98955a109f44209163f52d8dc4278cbbd9f5"),
+				V: 27,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			action := json.RawMessage(tt.action)
+			recovered, err := RecoverL1ActionSigner(action, tt.vaultAddress, 0, nil, tt.isMainnet, tt.signature)
+			require.NoError(t, err)
+			assert.Equal(t, signer, recovered)
+			assert.NoError(t, VerifyL1Action(action, tt.vaultAddress, 0, nil, tt.isMainnet, tt.signature, signer))
+
+			err = VerifyL1Action(action, tt.vaultAddress, 1, nil, tt.isMainnet, tt.signature, signer)
+			assert.True(t, errors.Is(err, ErrSignatureMismatch))
+		})
+	}
+}
+
+func TestVerifyL1Action_ExchangePayload(t *testing.T) {
+	// Address of testSDKKey, which signed these payloads with the Python SDK
+	signer := common.HexToAddress("0x14791697260E4c9A71f18484C9f997B308e59325")
+
+	tests := []struct {
+		fixture   string
+		isMainnet bool
+	}{
+		{"exchange_payload_vault.json", true},
+		{"exchange_payload_order_testnet.json", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			fixture, err := os.ReadFile(filepath.Join("testdata", tt.fixture))
+			require.NoError(t, err)
+
+			var payload struct {
+				Action       json.RawMessage `json:"action"`
+				Nonce        int64           `json:"nonce"`
+				Signature    Signature       `json:"signature"`
+				VaultAddress *string         `json:"vaultAddress"`
+				ExpiresAfter *int64          `json:"expiresAfter"`
+			}
+			require.NoError(t, json.Unmarshal(fixture, &payload))
+
+			var vaultAddress string
+			if payload.VaultAddress != nil {
+				vaultAddress = *payload.VaultAddress
+			}
+			assert.NoError(t, VerifyL1Action(
+				payload.Action, vaultAddress, payload.Nonce, payload.ExpiresAfter, tt.isMainnet, payload.Signature, signer,
+			))
+		})
+	}
+}
+
+func TestVerifyUserSignedAction(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privateKey.PublicKey)
+
+	action := map[string]any{
+		"type":         "tokenDelegate",
+		"validator":    "0x5ac99df645f3414876c816caa18b2d234024b487",
+		"wei":          uint64(100000000),
+		"isUndelegate": false,
+		"nonce":        uint64(1700000000000),
+	}
+	primaryType := "HyperliquidTransaction:TokenDelegate"
+	sig, err := SignUserSignedAction(privateKey, action, tokenDelegateFields, primaryType, false)
+	require.NoError(t, err)
+
+	recovered, err := RecoverUserSignedActionSigner(action, tokenDelegateFields, primaryType, sig)
+	require.NoError(t, err)
+	assert.Equal(t, signer, recovered)
+	assert.NoError(t, VerifyUserSignedAction(action, tokenDelegateFields, primaryType, sig, signer))
+
+	action["isUndelegate"] = true
+	err = VerifyUserSignedAction(action, tokenDelegateFields, primaryType, sig, signer)
+	assert.True(t, errors.Is(err, ErrSignatureMismatch))
+}
+
+func TestVerifyUserSignedAction_ExchangePayload(t *testing.T) {
+	// usdSend signed by testSDKKey in test_sign_usd_transfer_action of the
+	// Python SDK, as it posts the action
+	signer := common.HexToAddress("0x14791697260E4c9A71f18484C9f997B308e59325")
+
+	fixture, err := os.ReadFile(filepath.Join("testdata", "exchange_payload_usd_send.json"))
+	require.NoError(t, err)
+
+	var payload struct {
+		Action    map[string]any `json:"action"`
+		Nonce     int64          `json:"nonce"`
+		Signature Signature      `json:"signature"`
+	}
+	require.NoError(t, json.Unmarshal(fixture, &payload))
+
+	usdSend, err := UsdSendAction("0x5e9ee1089755c3435139848e47e6635505d5a13a", 1)
+	require.NoError(t, err)
+	assert.NoError(t, VerifyUserSignedAction(payload.Action, usdSend.Fields, usdSend.PrimaryType, payload.Signature, signer))
+
+	payload.Action["amount"] = "2"
+	err = VerifyUserSignedAction(payload.Action, usdSend.Fields, usdSend.PrimaryType, payload.Signature, signer)
+	assert.True(t, errors.Is(err, ErrSignatureMismatch))
+}
+
+func TestRecoverSigner_InvalidSignature(t *testing.T) {
+	_, err := recoverSigner(crypto.Keccak256([]byte("hash")), Signature{V: 27})
+	assert.Error(t, err)
+}
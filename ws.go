@@ -79,11 +79,17 @@ func (w *WebsocketClient) Subscribe(sub Subscription, callback func(WSMessage))
 	if callback == nil {
 		return 0, fmt.Errorf("callback cannot be nil")
 	}
+	if err := sub.l2BookOptions().Validate(); err != nil {
+		return 0, err
+	}
 
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
 	key := sub.key()
+	if err := w.checkL2BookAggregation(key); err != nil {
+		return 0, err
+	}
 	id := int(w.nextSubID.Add(1))
 
 	if w.subscriptions[key] == nil {
@@ -208,6 +214,22 @@ func (w *WebsocketClient) dispatch(msg WSMessage) {
 	w.mu.RLock()
 	defer w.mu.RUnlock()
 
+	if msg.Channel == "l2Book" {
+		// Books only tell their coin, which has a single aggregation
+		coin, ok := l2BookCoin(msg)
+		if !ok {
+			return
+		}
+		for key, subs := range w.subscriptions {
+			if key.typ == "l2Book" && key.coin == coin {
+				for _, sub := range subs {
+					sub.callback(msg)
+				}
+			}
+		}
+		return
+	}
+
 	for key, subs := range w.subscriptions {
 		if matchSubscription(key, msg) {
 			for _, sub := range subs {
@@ -241,13 +263,7 @@ func (w *WebsocketClient) reconnect() {
 func (w *WebsocketClient) resubscribeAll() error {
 	for key, subs := range w.subscriptions {
 		if len(subs) > 0 {
-			sub := Subscription{
-				Type:     key.typ,
-				Coin:     key.coin,
-				User:     key.user,
-				Interval: key.interval,
-			}
-			if err := w.sendSubscribe(sub); err != nil {
+			if err := w.sendSubscribe(key.subscription()); err != nil {
 				return fmt.Errorf("resubscribe: %w", err)
 			}
 		}
@@ -294,10 +310,51 @@ func (w *WebsocketClient) SubscribeToOrderbook(coin string, callback func(WSMess
 	return w.Subscribe(sub, callback)
 }
 
+// SubscribeToAggregatedOrderbook subscribes to the L2 book of coin with its
+// price levels aggregated as per opts. A coin can only be subscribed with one
+// aggregation at a time, the plain SubscribeToOrderbook included, since book
+// messages don't tell which aggregation they are for.
+func (w *WebsocketClient) SubscribeToAggregatedOrderbook(
+	coin string,
+	opts L2BookOptions,
+	callback func(WSMessage),
+) (int, error) {
+	sub := Subscription{Type: "l2Book", Coin: coin}
+	sub.NSigFigs, sub.Mantissa = opts.params()
+	return w.Subscribe(sub, callback)
+}
+
+// l2BookCoin returns the coin of an l2Book message.
+func l2BookCoin(msg WSMessage) (string, bool) {
+	var book struct {
+		Coin string `json:"coin"`
+	}
+	if err := json.Unmarshal(msg.Data, &book); err != nil || book.Coin == "" {
+		return "", false
+	}
+	return book.Coin, true
+}
+
+// checkL2BookAggregation rejects an l2Book subscription to a coin already
+// subscribed with other aggregation options. Book messages don't tell their
+// aggregation, so books of both would reach the subscribers of each.
+func (w *WebsocketClient) checkL2BookAggregation(key subKey) error {
+	if key.typ != "l2Book" {
+		return nil
+	}
+	for other, subs := range w.subscriptions {
+		if other.typ == key.typ && other.coin == key.coin && other != key && len(subs) > 0 {
+			return fmt.Errorf(
+				"l2Book of %s already subscribed with nSigFigs %d and mantissa %d",
+				key.coin, other.nSigFigs, other.mantissa,
+			)
+		}
+	}
+	return nil
+}
+
 func matchSubscription(key subKey, msg WSMessage) bool {
 	switch key.typ {
-	case "l2Book":
-		return msg.Channel == "l2Book"
 	case "trades":
 		return msg.Channel == "trades"
 	default:
@@ -16,6 +16,8 @@ type Subscription struct {
 	Coin     string `json:"coin,omitempty"`
 	User     string `json:"user,omitempty"`
 	Interval string `json:"interval,omitempty"`
+	NSigFigs *int   `json:"nSigFigs,omitempty"`
+	Mantissa *int   `json:"mantissa,omitempty"`
 }
 
 type subKey struct {
@@ -23,17 +25,33 @@ type subKey struct {
 	coin     string
 	user     string
 	interval string
+	nSigFigs int
+	mantissa int
 }
 
 func (s Subscription) key() subKey {
+	opts := s.l2BookOptions()
 	return subKey{
 		typ:      s.Type,
 		coin:     s.Coin,
 		user:     s.User,
 		interval: s.Interval,
+		nSigFigs: opts.NSigFigs,
+		mantissa: opts.Mantissa,
 	}
 }
 
+func (k subKey) subscription() Subscription {
+	sub := Subscription{
+		Type:     k.typ,
+		Coin:     k.coin,
+		User:     k.user,
+		Interval: k.interval,
+	}
+	sub.NSigFigs, sub.Mantissa = L2BookOptions{NSigFigs: k.nSigFigs, Mantissa: k.mantissa}.params()
+	return sub
+}
+
 type WsCommand struct {
 	Method       string        `json:"method"`
 	Subscription *Subscription `json:"subscription,omitempty"`